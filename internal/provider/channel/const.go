@@ -23,7 +23,14 @@ const (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &ChannelResource{}
-	_ resource.ResourceWithConfigure   = &ChannelResource{}
-	_ resource.ResourceWithImportState = &ChannelResource{}
+	_ resource.Resource                   = &ChannelResource{}
+	_ resource.ResourceWithConfigure      = &ChannelResource{}
+	_ resource.ResourceWithImportState    = &ChannelResource{}
+	_ resource.ResourceWithValidateConfig = &ChannelResource{}
+)
+
+// The actions that can be taken when a channel is destroyed.
+const (
+	onDestroyDelete  = "delete"
+	onDestroyArchive = "archive"
 )
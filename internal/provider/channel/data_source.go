@@ -3,6 +3,7 @@ package channel
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/JustARecord/go-discordutils/base/channel"
 	discord "github.com/JustARecord/go-discordutils/utils"
@@ -12,7 +13,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -52,6 +52,10 @@ func (d *ChannelDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				Optional:    true,
 				Computed:    true,
 			},
+			"match_index": schema.Int32Attribute{
+				Description: common.MatchIndexDescription("channel"),
+				Optional:    true,
+			},
 			"topic": schema.StringAttribute{
 				Description: "The topic of the channel.",
 				Computed:    true,
@@ -89,7 +93,8 @@ func (d *ChannelDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 			// 	Computed:    true,
 			// },
 			"parent_id": schema.StringAttribute{
-				Description: "The ID of the parent category for a channel.",
+				Description: "The ID of the parent category for a channel. When set with name, only the channels in this category are matched.",
+				Optional:    true,
 				Computed:    true,
 			},
 			"children": schema.ListAttribute{
@@ -98,19 +103,20 @@ func (d *ChannelDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				ElementType: types.StringType,
 			},
 			"last_pin_timestamp": schema.StringAttribute{
-				Description: "The timestamp of the last pinned message in the channel.",
+				Description: "The timestamp of the last pinned message in the channel. " +
+					"It changes when messages are pinned, for example by discord_channel_pins, and is refreshed on the next plan.",
+				Computed: true,
+			},
+			"thread_metadata": schema.SingleNestedAttribute{
+				Description: "The thread metadata, if the channel is a thread.",
 				Computed:    true,
+				Attributes:  ThreadMetadataSchema,
+			},
+			"thread_member": schema.SingleNestedAttribute{
+				Description: "The thread member of the current user, if the channel is a thread the user has joined.",
+				Computed:    true,
+				Attributes:  ThreadMemberSchema,
 			},
-			// "thread_metadata": schema.SingleNestedAttribute{
-			// 	Description: "Thread metadata for the channel.",
-			// 	Computed:    true,
-			// 	Attributes:  ThreadMetadataSchema,
-			// },
-			// "thread_member": schema.ListAttribute{
-			// 	Description: "Thread member metadata for the channel.",
-			// 	Computed:    true,
-			// 	CustomType:  ThreadMember,
-			// },
 			"flags": schema.ListAttribute{
 				Description: "Channel flags.",
 				Computed:    true,
@@ -151,7 +157,7 @@ func (d *ChannelDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 func (d *ChannelDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	ctx = tflog.SetField(ctx, "operation", "read")
 
-	var state, provided ChannelDataSourceModel
+	var state, provided ChannelLookupModel
 
 	// Read the configuration data into the provided struct.
 	diags := req.Config.Get(ctx, &provided)
@@ -189,7 +195,20 @@ func (d *ChannelDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	if id != "" {
 		result, err = d.client.Channel(id)
 	} else if name != "" {
-		result, err = channel.FetchByName(ctx, d.client, guild_id, name)
+		var channels []*discordgo.Channel
+
+		channels, diags = FetchAllByName(ctx, d.client, guild_id, name)
+		resp.Diagnostics.Append(diags...)
+
+		if parentID := provided.ParentID.ValueString(); parentID != "" {
+			channels = slices.DeleteFunc(channels, func(c *discordgo.Channel) bool {
+				return c.ParentID != parentID
+			})
+		}
+
+		if !resp.Diagnostics.HasError() {
+			result, err = common.SelectMatch("channel", name, channels, channelID, provided.MatchIndex)
+		}
 	} else {
 		resp.Diagnostics.AddError(
 			"Invalid Resource Configuration",
@@ -208,6 +227,14 @@ func (d *ChannelDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
+	if parentID := provided.ParentID.ValueString(); parentID != "" && result.ParentID != parentID {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to get %s", datasourceMetadataName),
+			fmt.Sprintf("The channel %s is not in the parent category %s.", result.ID, parentID),
+		)
+		return
+	}
+
 	children, err := channel.FetchChildren(ctx, d.client, guild_id, result)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -220,56 +247,29 @@ func (d *ChannelDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	flags := discord.ListStringify(result.Flags)
+	// The thread member of the current user is not included when fetching a thread by ID
+	if result.ThreadMetadata != nil && result.Member == nil {
+		member, err := d.client.ThreadMember(result.ID, "@me", false, discordgo.WithContext(ctx))
+		if err != nil && !discord.NotFoundError(err) {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to get thread member for %s", datasourceMetadataName),
+				err.Error(),
+			)
+			return
+		}
 
-	flagsList, diags := common.ToListType[string, basetypes.StringType](flags)
-	resp.Diagnostics.Append(diags...)
-
-	appliedTags, diags := common.ToListType[string, basetypes.StringType](result.AppliedTags)
-	resp.Diagnostics.Append(diags...)
+		result.Member = member
+	}
 
-	childrenIDs := channel.Names(children)
-	childrenList, diags := common.ToListType[string, basetypes.StringType](childrenIDs)
+	model, diags := ToDataSourceModel(result, children)
 	resp.Diagnostics.Append(diags...)
-
-	// availableTags, diags := common.ToListType(channel.AvailableTags)
-	// resp.Diagnostics.Append(diags...)
-
-	// attrTypes, attrValues := StructToAttrValues(channel.ThreadMetadata)
-	// tflog.Info(ctx, fmt.Sprintf("ThreadMetadata: %v || %v", attrTypes, attrValues))
-
-	// threadMetadata, diags := types.ObjectValueFrom(ctx, attrTypes, channel.ThreadMetadata)
-	// resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Map the result data to the state.
-	state = ChannelDataSourceModel{
-		ID:               types.StringValue(result.ID),
-		Type:             types.StringValue(discord.Stringify(result.Type)),
-		GuildID:          types.StringValue(result.GuildID),
-		Position:         types.Int32Value(int32(result.Position)),
-		Name:             types.StringValue(result.Name),
-		Topic:            types.StringValue(result.Topic),
-		NSFW:             types.BoolValue(result.NSFW),
-		Bitrate:          types.Int32Value(int32(result.Bitrate)),
-		UserLimit:        types.Int32Value(int32(result.UserLimit)),
-		RateLimitPerUser: types.Int32Value(int32(result.RateLimitPerUser)),
-		Icon:             types.StringValue(result.Icon),
-		OwnerID:          types.StringValue(result.OwnerID),
-		ApplicationID:    types.StringValue(result.ApplicationID),
-		// Managed:                       types.BoolValue(channel.Managed),
-		ParentID:         types.StringValue(result.ParentID),
-		Children:         childrenList,
-		LastPinTimestamp: types.StringValue(common.StrDiscordTime(result.LastPinTimestamp, "ISO8601")),
-		// ThreadMetadata:   threadMetadata,
-		// ThreadMember:                  common.ThreadMemberValue(channel.ThreadMember),
-		Flags: flagsList,
-		// AvailableTags: availableTags,
-		AppliedTags: appliedTags,
-		// DefaultReactionEmoji:          common.ForumDefaultReactionValue(&channel.DefaultReactionEmoji),
-		DefaultThreadRateLimitPerUser: types.Int32Value(int32(result.DefaultThreadRateLimitPerUser)),
-		DefaultSortOrder:              types.StringValue(discord.Stringify(result.DefaultSortOrder)),
-		DefaultForumLayout:            types.StringValue(discord.Stringify(result.DefaultForumLayout)),
-	}
+	state.ChannelDataSourceModel = *model
+	state.MatchIndex = provided.MatchIndex
 
 	// Set state
 	diags = resp.State.Set(ctx, &state)
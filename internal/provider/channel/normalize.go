@@ -0,0 +1,172 @@
+package channel
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/JustARecord/go-discordutils/base/channel"
+	discord "github.com/JustARecord/go-discordutils/utils"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// normalizedChannelTypes are the channel types whose names are normalized by Discord.
+var normalizedChannelTypes = []discordgo.ChannelType{
+	discordgo.ChannelTypeGuildText,
+	discordgo.ChannelTypeGuildNews,
+	discordgo.ChannelTypeGuildForum,
+	discordgo.ChannelTypeGuildMedia,
+}
+
+var (
+	whitespaceRegex = regexp.MustCompile(`\s+`)
+	dashesRegex     = regexp.MustCompile(`-{2,}`)
+)
+
+// NormalizeName applies Discord's channel name normalization for the provided channel type.
+// Text, announcement, forum and media channel names are lowercased, and whitespace is replaced with dashes.
+// An empty channel type is treated as a text channel, as this is the default when creating a channel.
+func NormalizeName(channelType, name string) string {
+	if channelType == "" {
+		channelType = discord.Stringify(discordgo.ChannelTypeGuildText)
+	}
+
+	normalized := slices.ContainsFunc(normalizedChannelTypes, func(t discordgo.ChannelType) bool {
+		return discord.Stringify(t) == channelType
+	})
+
+	if !normalized {
+		return name
+	}
+
+	name = strings.ToLower(strings.TrimSpace(name))
+	name = whitespaceRegex.ReplaceAllString(name, "-")
+	name = dashesRegex.ReplaceAllString(name, "-")
+
+	return name
+}
+
+// keepName returns the configured name if Discord normalized it to the name of the channel.
+// This keeps the configured form in state, so that the plan does not report a change.
+func keepName(configured types.String, result *discordgo.Channel) types.String {
+	if !configured.IsNull() && !configured.IsUnknown() && NormalizeName(discord.Stringify(result.Type), configured.ValueString()) == result.Name {
+		return configured
+	}
+
+	return types.StringValue(result.Name)
+}
+
+// channelID returns the ID of the channel, used to order the channels matching a name.
+func channelID(c *discordgo.Channel) string {
+	return c.ID
+}
+
+// FetchAllByName fetches all the channels with the provided name, as Discord does not require channel names to be unique.
+// Exact matches are preferred, otherwise the name is matched against the normalized channel names,
+// and a warning is returned describing the normalized name.
+func FetchAllByName(ctx context.Context, client *discordgo.Session, guildID, name string) ([]*discordgo.Channel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	channels, err := channel.FetchAll(ctx, client, guildID)
+	if err != nil {
+		diags.AddError("Failed to get channels", err.Error())
+		return nil, diags
+	}
+
+	matches := slices.DeleteFunc(slices.Clone(channels), func(c *discordgo.Channel) bool {
+		return c.Name != name
+	})
+
+	if len(matches) > 0 {
+		return matches, diags
+	}
+
+	matches = slices.DeleteFunc(channels, func(c *discordgo.Channel) bool {
+		return NormalizeName(discord.Stringify(c.Type), name) != c.Name
+	})
+
+	if len(matches) > 0 {
+		diags.AddWarning(
+			"Channel name normalized",
+			fmt.Sprintf("No channel is named %q, but Discord normalizes this name to %q, which was used to look up the channel.", name, matches[0].Name),
+		)
+	}
+
+	return matches, diags
+}
+
+// FetchByName fetches a channel by name, see FetchAllByName for how the name is matched.
+// An error listing the candidate IDs is returned when several channels share the name.
+func FetchByName(ctx context.Context, client *discordgo.Session, guildID, name string) (*discordgo.Channel, diag.Diagnostics) {
+	channels, diags := FetchAllByName(ctx, client, guildID, name)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	result, err := common.SelectUnique("channel", name, channels, channelID)
+	if err != nil {
+		diags.AddError("Failed to get channel", err.Error())
+		return nil, diags
+	}
+
+	return result, diags
+}
+
+// normalizedNameModifier warns when Discord will normalize the configured channel name.
+type normalizedNameModifier struct{}
+
+// NormalizedName returns a plan modifier that warns when the configured channel name will be normalized by Discord.
+// The configured name is kept in state as long as it normalizes to the name of the channel.
+func NormalizedName() planmodifier.String {
+	return normalizedNameModifier{}
+}
+
+// Description returns a plain text description of the modifier's behavior.
+func (m normalizedNameModifier) Description(_ context.Context) string {
+	return "Warns when Discord normalizes the channel name, and accepts the configured name as equal to the normalized name."
+}
+
+// MarkdownDescription returns a markdown formatted description of the modifier's behavior.
+func (m normalizedNameModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// PlanModifyString implements the plan modification logic.
+func (m normalizedNameModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	// Nothing to report if the name is unchanged
+	if req.ConfigValue.Equal(req.StateValue) {
+		return
+	}
+
+	var channelType types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &channelType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An unknown type is not configured, and defaults to a text channel.
+	name := req.ConfigValue.ValueString()
+	normalized := NormalizeName(channelType.ValueString(), name)
+
+	if normalized == name {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		req.Path,
+		"Channel name will be normalized",
+		fmt.Sprintf("Discord stores the channel name %q as %q. The configured name is kept in state as long as it matches the normalized name.", name, normalized),
+	)
+}
@@ -0,0 +1,225 @@
+package channel
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	discord "github.com/JustARecord/go-discordutils/utils"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The types of a permission overwrite.
+const (
+	overwriteTypeRole   = "role"
+	overwriteTypeMember = "member"
+)
+
+type PermissionOverwrite struct {
+	// The ID of the role or member.
+	ID types.String `tfsdk:"id"`
+
+	// The type of the overwrite, either "role" or "member".
+	Type types.String `tfsdk:"type"`
+
+	// The permissions that are allowed.
+	Allow types.Set `tfsdk:"allow"`
+
+	// The permissions that are denied.
+	Deny types.Set `tfsdk:"deny"`
+}
+
+var PermissionOverwriteAttrTypes = map[string]attr.Type{
+	"id":    types.StringType,
+	"type":  types.StringType,
+	"allow": types.SetType{ElemType: types.StringType},
+	"deny":  types.SetType{ElemType: types.StringType},
+}
+
+var PermissionOverwriteSchema = schema.NestedAttributeObject{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the role or member.",
+			Required:    true,
+		},
+		"type": schema.StringAttribute{
+			Description: fmt.Sprintf("The type of the overwrite, either '%s' or '%s'.", overwriteTypeRole, overwriteTypeMember),
+			Required:    true,
+		},
+		"allow": schema.SetAttribute{
+			Description: "The permissions that are allowed.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"deny": schema.SetAttribute{
+			Description: "The permissions that are denied.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+	},
+}
+
+// FromPermissionOverwrites converts the permission overwrites from the schema data to Discord permission overwrites.
+func FromPermissionOverwrites(ctx context.Context, set types.Set) ([]*discordgo.PermissionOverwrite, diag.Diagnostics) {
+	var overwrites []PermissionOverwrite
+
+	diags := set.ElementsAs(ctx, &overwrites, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	result := make([]*discordgo.PermissionOverwrite, 0, len(overwrites))
+
+	for _, o := range overwrites {
+		var allow, deny []string
+
+		diags.Append(o.Allow.ElementsAs(ctx, &allow, false)...)
+		diags.Append(o.Deny.ElementsAs(ctx, &deny, false)...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		overwriteType := discordgo.PermissionOverwriteTypeRole
+		if o.Type.ValueString() == overwriteTypeMember {
+			overwriteType = discordgo.PermissionOverwriteTypeMember
+		}
+
+		result = append(result, &discordgo.PermissionOverwrite{
+			ID:    o.ID.ValueString(),
+			Type:  overwriteType,
+			Allow: discord.CalcPermissions(allow),
+			Deny:  discord.CalcPermissions(deny),
+		})
+	}
+
+	return result, diags
+}
+
+// ValidatePermissionOverwrites validates the type and the permission names of the configured permission overwrites.
+func ValidatePermissionOverwrites(ctx context.Context, set types.Set, resourceType string) diag.Diagnostics {
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+
+	var overwrites []PermissionOverwrite
+
+	diags := set.ElementsAs(ctx, &overwrites, false)
+	if diags.HasError() {
+		return diags
+	}
+
+	attrPath := path.Root("permission_overwrites")
+
+	for _, o := range overwrites {
+		if !o.Type.IsNull() && !o.Type.IsUnknown() {
+			if t := o.Type.ValueString(); t != overwriteTypeRole && t != overwriteTypeMember {
+				diags.AddAttributeError(
+					attrPath,
+					fmt.Sprintf("Invalid %s Configuration", resourceType),
+					fmt.Sprintf("The type of a permission overwrite must be either '%s' or '%s', got: %q.", overwriteTypeRole, overwriteTypeMember, t),
+				)
+			}
+		}
+
+		diags.Append(common.ValidatePermissions(attrPath, o.Allow.Elements(), resourceType)...)
+		diags.Append(common.ValidatePermissions(attrPath, o.Deny.Elements(), resourceType)...)
+	}
+
+	return diags
+}
+
+// permissionSet converts the permission names to a set. An empty list is stored as null, matching an omitted
+// allow or deny, unless the prior value was an explicit empty set.
+func permissionSet(ctx context.Context, names []string, prior types.Set) (types.Set, diag.Diagnostics) {
+	if len(names) == 0 {
+		if !prior.IsNull() && !prior.IsUnknown() {
+			return types.SetValueMust(types.StringType, []attr.Value{}), nil
+		}
+
+		return types.SetNull(types.StringType), nil
+	}
+
+	return types.SetValueFrom(ctx, types.StringType, names)
+}
+
+// ToPermissionOverwrites converts Discord permission overwrites to the schema data.
+// The prior overwrites are used to keep explicit empty allow and deny sets.
+func ToPermissionOverwrites(ctx context.Context, overwrites []*discordgo.PermissionOverwrite, prior types.Set) (types.Set, diag.Diagnostics) {
+	elemType := types.ObjectType{AttrTypes: PermissionOverwriteAttrTypes}
+	result := make([]PermissionOverwrite, 0, len(overwrites))
+
+	var previous []PermissionOverwrite
+	if !prior.IsNull() && !prior.IsUnknown() {
+		if diags := prior.ElementsAs(ctx, &previous, false); diags.HasError() {
+			return types.SetNull(elemType), diags
+		}
+	}
+
+	for _, o := range overwrites {
+		allow, deny, err := discord.ParseOverwrite(o)
+		if err != nil {
+			return types.SetNull(elemType), diag.Diagnostics{
+				diag.NewErrorDiagnostic("failed to parse permission overwrite", err.Error()),
+			}
+		}
+
+		overwriteType := strings.ToLower(discord.Stringify(o.Type))
+
+		match := PermissionOverwrite{
+			Allow: types.SetNull(types.StringType),
+			Deny:  types.SetNull(types.StringType),
+		}
+
+		if idx := slices.IndexFunc(previous, func(p PermissionOverwrite) bool {
+			return p.ID.ValueString() == o.ID && p.Type.ValueString() == overwriteType
+		}); idx != -1 {
+			match = previous[idx]
+		}
+
+		allowSet, diags := permissionSet(ctx, allow, match.Allow)
+		if diags.HasError() {
+			return types.SetNull(elemType), diags
+		}
+
+		denySet, diags := permissionSet(ctx, deny, match.Deny)
+		if diags.HasError() {
+			return types.SetNull(elemType), diags
+		}
+
+		result = append(result, PermissionOverwrite{
+			ID:    types.StringValue(o.ID),
+			Type:  types.StringValue(overwriteType),
+			Allow: allowSet,
+			Deny:  denySet,
+		})
+	}
+
+	return types.SetValueFrom(ctx, elemType, result)
+}
+
+// PermissionsSynced reports whether the channel has exactly the same permission overwrites as its parent.
+func PermissionsSynced(channel, parent *discordgo.Channel) bool {
+	if len(channel.PermissionOverwrites) != len(parent.PermissionOverwrites) {
+		return false
+	}
+
+	for _, o := range channel.PermissionOverwrites {
+		match := slices.ContainsFunc(parent.PermissionOverwrites, func(p *discordgo.PermissionOverwrite) bool {
+			return p.ID == o.ID && p.Type == o.Type && p.Allow == o.Allow && p.Deny == o.Deny
+		})
+
+		if !match {
+			return false
+		}
+	}
+
+	return true
+}
@@ -3,6 +3,7 @@ package channel
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/JustARecord/go-discordutils/base/channel"
@@ -10,6 +11,7 @@ import (
 	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
 	"github.com/bwmarrin/discordgo"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -62,7 +64,7 @@ func (r *ChannelResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Required:    true,
 			},
 			"position": schema.Int32Attribute{
-				Description: "The position of the channel.",
+				Description: "The position of the channel. Use discord_channel_order to order several channels of a category at once.",
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.Int32{
@@ -70,11 +72,12 @@ func (r *ChannelResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				},
 			},
 			"name": schema.StringAttribute{
-				Description: "The name of the channel.",
+				Description: "The name of the channel. Discord normalizes the names of text, announcement, forum and media channels; the configured name is kept as long as it matches the normalized name.",
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					NormalizedName(),
 				},
 			},
 			"topic": schema.StringAttribute{
@@ -155,22 +158,23 @@ func (r *ChannelResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				},
 			},
 			"last_pin_timestamp": schema.StringAttribute{
-				Description: "The timestamp of the last pinned message in the channel.",
-				Computed:    true,
+				Description: "The timestamp of the last pinned message in the channel. " +
+					"It changes when messages are pinned, for example by discord_channel_pins, and is refreshed on the next plan.",
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
-			// "thread_metadata": schema.SingleNestedAttribute{
-			// 	Description: "Thread metadata for the channel.",
-			// 	Computed:    true,
-			// 	Attributes:  ThreadMetadataSchema,
-			// },
-			// "thread_member": schema.ListAttribute{
-			// 	Description: "Thread member metadata for the channel.",
-			// 	Computed:    true,
-			// 	CustomType:  ThreadMember,
-			// },
+			"thread_metadata": schema.ObjectAttribute{
+				Description:    "The thread metadata, if the channel is a thread.",
+				Computed:       true,
+				AttributeTypes: ThreadMetadataAttrTypes,
+			},
+			"thread_member": schema.ObjectAttribute{
+				Description:    "The thread member of the current user, if the channel is a thread the user has joined.",
+				Computed:       true,
+				AttributeTypes: ThreadMemberAttrTypes,
+			},
 			"flags": schema.ListAttribute{
 				Description: "Channel flags.",
 				Computed:    true,
@@ -218,10 +222,141 @@ func (r *ChannelResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"permission_overwrites": schema.SetNestedAttribute{
+				Description:  "The permission overwrites of the channel. When set, the overwrites are managed authoritatively and overwrites not listed are removed. Conflicts with sync_permissions_with_parent.",
+				Optional:     true,
+				NestedObject: PermissionOverwriteSchema,
+			},
+			"sync_permissions_with_parent": schema.BoolAttribute{
+				Description: "Whether to copy the permission overwrites of the parent category. When the channel drifts out of sync, this is reported as false. Conflicts with permission_overwrites.",
+				Optional:    true,
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Description: "Whether the channel is protected from being destroyed. While enabled, destroying or replacing the channel fails. Set it to false and apply before destroying the channel.",
+				Optional:    true,
+			},
+			"on_destroy": schema.StringAttribute{
+				Description: "What happens to the channel when it is destroyed, either 'delete' or 'archive'. Archiving moves the channel into archive_category_id and stops everyone from posting in it, keeping the message history. Defaults to 'delete'.",
+				Optional:    true,
+			},
+			"archive_category_id": schema.StringAttribute{
+				Description: "The ID of the category the channel is moved into when on_destroy is 'archive'.",
+				Optional:    true,
+			},
+			"source_channel_id": schema.StringAttribute{
+				Description: "The ID of a channel in the same guild to copy when the channel is created. " +
+					"Every attribute that is not set, such as the type, topic, slowmode, forum tags and category, and the permission overwrites are copied from it. " +
+					"After creation, the channel is managed independently and changing this has no effect.",
+				Optional: true,
+			},
+			"if_exists": schema.StringAttribute{
+				Description: common.IfExistsDescription("channel"),
+				Optional:    true,
+			},
 		},
 	}
 }
 
+// ValidateConfig validates the resource configuration.
+func (r *ChannelResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ChannelResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.SyncPermissionsWithParent.ValueBool() {
+		if !config.PermissionOverwrites.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("sync_permissions_with_parent"),
+				fmt.Sprintf("Invalid %s Configuration", resourceMetadataType),
+				"sync_permissions_with_parent cannot be used together with permission_overwrites.",
+			)
+		}
+
+		if config.ParentID.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("sync_permissions_with_parent"),
+				fmt.Sprintf("Invalid %s Configuration", resourceMetadataType),
+				"sync_permissions_with_parent requires parent_id to be set.",
+			)
+		}
+	}
+
+	resp.Diagnostics.Append(ValidatePermissionOverwrites(ctx, config.PermissionOverwrites, resourceMetadataType)...)
+	resp.Diagnostics.Append(common.ValidateIfExists(config.IfExists, resourceMetadataType)...)
+
+	if config.OnDestroy.IsNull() || config.OnDestroy.IsUnknown() {
+		return
+	}
+
+	switch config.OnDestroy.ValueString() {
+	case onDestroyDelete:
+	case onDestroyArchive:
+		if config.ArchiveCategoryID.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("archive_category_id"),
+				fmt.Sprintf("Invalid %s Configuration", resourceMetadataType),
+				"archive_category_id must be set when on_destroy is 'archive'.",
+			)
+		}
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("on_destroy"),
+			fmt.Sprintf("Invalid %s Configuration", resourceMetadataType),
+			fmt.Sprintf("on_destroy must be either '%s' or '%s', got: %q.", onDestroyDelete, onDestroyArchive, config.OnDestroy.ValueString()),
+		)
+	}
+}
+
+// setupOverwrites resolves the permission overwrites of the plan into the channel parameters.
+// It returns the desired overwrites and whether the overwrites of the channel are managed.
+func (r *ChannelResource) setupOverwrites(ctx context.Context, plan *ChannelResourceModel, params *discordgo.ChannelEdit) ([]*discordgo.PermissionOverwrite, bool, diag.Diagnostics) {
+	var parent *discordgo.Channel
+
+	if plan.SyncPermissionsWithParent.ValueBool() {
+		var err error
+
+		parent, err = channel.FetchByID(ctx, r.client, plan.GuildID.ValueString(), plan.ParentID.ValueString())
+		if err != nil {
+			return nil, false, diag.Diagnostics{
+				diag.NewErrorDiagnostic(fmt.Sprintf("Failed to get parent for %s", resourceMetadataName), err.Error()),
+			}
+		}
+	}
+
+	overwrites, manage, diags := setupOverwrites(ctx, plan, parent)
+	if diags.HasError() || !manage {
+		return nil, manage, diags
+	}
+
+	params.PermissionOverwrites = overwrites
+
+	return overwrites, manage, diags
+}
+
+// pruneOverwrites removes the permission overwrites of the channel that are not desired.
+// Discord ignores an empty list of overwrites on edit, so these are deleted one by one.
+func (r *ChannelResource) pruneOverwrites(ctx context.Context, result *discordgo.Channel, desired []*discordgo.PermissionOverwrite) error {
+	for _, o := range result.PermissionOverwrites {
+		keep := slices.ContainsFunc(desired, func(d *discordgo.PermissionOverwrite) bool {
+			return d.ID == o.ID
+		})
+
+		if keep {
+			continue
+		}
+
+		if err := r.client.ChannelPermissionDelete(result.ID, o.ID, discordgo.WithContext(ctx)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *ChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	ctx = tflog.SetField(ctx, "operation", "create")
@@ -258,8 +393,62 @@ func (r *ChannelResource) Create(ctx context.Context, req resource.CreateRequest
 
 	params := setupParams(&plan)
 
-	// Create the resource
-	result, err := channel.CreateWithParams(ctx, r.client, guild_id, name, channelTypeStr, params)
+	// Seed the unset attributes from the source channel
+	if !plan.SourceChannelID.IsNull() && !plan.SourceChannelID.IsUnknown() {
+		source, err := channel.FetchByID(ctx, r.client, guild_id, plan.SourceChannelID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to get source channel for %s", resourceMetadataName),
+				err.Error(),
+			)
+			return
+		}
+
+		if source.GuildID != guild_id {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("source_channel_id"),
+				fmt.Sprintf("Invalid %s Configuration", resourceMetadataType),
+				fmt.Sprintf("The source channel %s is not in guild %s.", source.ID, guild_id),
+			)
+			return
+		}
+
+		if plan.Type.IsNull() || plan.Type.IsUnknown() {
+			channelTypeStr = discord.Stringify(source.Type)
+		}
+
+		seedParams(&plan, source, params)
+	}
+
+	overwrites, manageOverwrites, diags := r.setupOverwrites(ctx, &plan, params)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existing, err := findExisting(ctx, r.client, guild_id, name, channelTypeStr, plan.IfExists.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to create %s", resourceMetadataName),
+			err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result *discordgo.Channel
+
+	// Create the resource, or adopt the existing channel
+	if existing != nil {
+		tflog.Info(ctx, fmt.Sprintf("Adopting existing %s %s: %s", resourceMetadataName, resourceMetadataType, existing.ID))
+
+		result, err = channel.UpdateByID(ctx, r.client, existing.ID, params)
+	} else {
+		result, err = channel.CreateWithParams(ctx, r.client, guild_id, name, channelTypeStr, params)
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Failed to create %s", resourceMetadataName),
@@ -271,6 +460,19 @@ func (r *ChannelResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	if manageOverwrites {
+		if err := r.pruneOverwrites(ctx, result, overwrites); err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to set permission overwrites for %s", resourceMetadataName),
+				err.Error(),
+			)
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Info(ctx, fmt.Sprintf("Reading plan %s %s: %v", resourceMetadataName, resourceMetadataType, plan))
 
 	if diags := UpdateModel(result, &plan, nil); diags != nil {
@@ -357,6 +559,12 @@ func (r *ChannelResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	params := setupParams(&plan)
 
+	overwrites, manageOverwrites, diags := r.setupOverwrites(ctx, &plan, params)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update the resource
 	result, err := channel.UpdateByID(ctx, r.client, id, params)
 	if err != nil {
@@ -370,6 +578,19 @@ func (r *ChannelResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	if manageOverwrites {
+		if err := r.pruneOverwrites(ctx, result, overwrites); err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to set permission overwrites for %s", resourceMetadataName),
+				err.Error(),
+			)
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Info(ctx, fmt.Sprintf("Reading provided %s %s: %v", resourceMetadataName, resourceMetadataType, plan))
 	tflog.Info(ctx, fmt.Sprintf("Reading state %s %s: %v", resourceMetadataName, resourceMetadataType, state))
 
@@ -449,15 +670,37 @@ func (r *ChannelResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 	guild_id := state.GuildID.ValueString()
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Cannot delete protected %s", resourceMetadataName),
+			fmt.Sprintf("The %s %s has deletion_protection enabled. Set deletion_protection to false and apply before destroying or replacing it.", resourceMetadataName, state.Name.ValueString()),
+		)
+		return
+	}
+
+	id := state.ID.ValueString()
+
+	if id == "" && !state.Name.IsNull() {
+		var result *discordgo.Channel
+
+		result, diags = FetchByName(ctx, r.client, guild_id, state.Name.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		id = result.ID
+	}
+
 	var err error
 
-	// Delete existing resource
-	if !state.ID.IsNull() {
-		err = channel.DeleteByID(ctx, r.client, state.ID.ValueString())
-	} else if !state.Name.IsNull() {
-		err = channel.DeleteByName(ctx, r.client, guild_id, state.Name.ValueString())
-	} else {
+	// Delete existing resource, or archive it
+	if id == "" {
 		err = fmt.Errorf("either the id or the name must be set for the %s %s", resourceMetadataName, resourceMetadataType)
+	} else if state.OnDestroy.ValueString() == onDestroyArchive {
+		err = r.archive(ctx, guild_id, id, state.ArchiveCategoryID.ValueString())
+	} else {
+		err = channel.DeleteByID(ctx, r.client, id)
 	}
 
 	if err != nil {
@@ -473,6 +716,23 @@ func (r *ChannelResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 }
 
+// archive moves the channel into the archive category, and locks its permission overwrites so nobody can post in it.
+func (r *ChannelResource) archive(ctx context.Context, guildID, id, categoryID string) error {
+	result, err := channel.FetchByID(ctx, r.client, guildID, id)
+	if err != nil {
+		return err
+	}
+
+	params := &discordgo.ChannelEdit{
+		ParentID:             categoryID,
+		PermissionOverwrites: archiveOverwrites(guildID, result.PermissionOverwrites),
+	}
+
+	_, err = channel.UpdateByID(ctx, r.client, id, params)
+
+	return err
+}
+
 // Import imports the resource and sets the Terraform state.
 func (r *ChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	ctx = tflog.SetField(ctx, "operation", "import")
@@ -548,7 +808,8 @@ func (r *ChannelResource) Read(ctx context.Context, req resource.ReadRequest, re
 	if id != "" {
 		result, err = channel.FetchByID(ctx, r.client, guild_id, id)
 	} else if name != "" {
-		result, err = channel.FetchByName(ctx, r.client, guild_id, name)
+		result, diags = FetchByName(ctx, r.client, guild_id, name)
+		resp.Diagnostics.Append(diags...)
 	} else {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Invalid %s Configuration", resourceMetadataType),
@@ -602,6 +863,35 @@ func (r *ChannelResource) Read(ctx context.Context, req resource.ReadRequest, re
 	// Set the children
 	state.Children = childrenList
 
+	// Report the current overwrites if they are managed by this resource
+	if !provided.PermissionOverwrites.IsNull() {
+		state.PermissionOverwrites, diags = ToPermissionOverwrites(ctx, result.PermissionOverwrites, provided.PermissionOverwrites)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	// Report a channel that drifted out of sync with its parent
+	if provided.SyncPermissionsWithParent.ValueBool() {
+		synced := false
+
+		if result.ParentID != "" {
+			parent, err := channel.FetchByID(ctx, r.client, state.GuildID.ValueString(), result.ParentID)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					fmt.Sprintf("Failed to get parent for %s", resourceMetadataName),
+					err.Error(),
+				)
+			} else {
+				synced = PermissionsSynced(result, parent)
+			}
+		}
+
+		state.SyncPermissionsWithParent = types.BoolValue(synced)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Revert last_updated to the plan value
 	if !provided.LastUpdated.IsNull() {
 		state.LastUpdated = provided.LastUpdated
@@ -1,9 +1,13 @@
 package channel
 
 import (
-	"time"
+	"context"
 
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -15,40 +19,43 @@ type ThreadMetadata struct {
 	AutoArchiveDuration types.Int32 `tfsdk:"auto_archive_duration"`
 
 	// Timestamp when the thread's archive status was last changed, used for calculating recent activity
-	ArchiveTimestamp *time.Time `tfsdk:"archive_timestamp"`
+	ArchiveTimestamp types.String `tfsdk:"archive_timestamp"`
 
 	// Whether the thread is locked; when a thread is locked, only users with MANAGE_THREADS can unarchive it
 	Locked types.Bool `tfsdk:"locked"`
 
 	// Whether non-moderators can add other non-moderators to a thread; only available on private threads
 	Invitable types.Bool `tfsdk:"invitable"`
+}
 
-	// Timestamp when the thread was created; only populated for threads created after 2022-01-09
-	CreateTimestamp *time.Time `tfsdk:"create_timestamp"`
+var ThreadMetadataAttrTypes = map[string]attr.Type{
+	"archived":              types.BoolType,
+	"auto_archive_duration": types.Int32Type,
+	"archive_timestamp":     types.StringType,
+	"locked":                types.BoolType,
+	"invitable":             types.BoolType,
 }
 
 var ThreadMetadataSchema = map[string]schema.Attribute{
 	"archived": schema.BoolAttribute{
-		Optional: true,
-		Computed: true,
+		Description: "Whether the thread is archived.",
+		Computed:    true,
 	},
 	"auto_archive_duration": schema.Int32Attribute{
-		Optional: true,
-		Computed: true,
+		Description: "The number of minutes of inactivity after which the thread is archived, one of 60, 1440, 4320 or 10080.",
+		Computed:    true,
 	},
 	"archive_timestamp": schema.StringAttribute{
-		Computed: true,
+		Description: "The timestamp when the archive status of the thread was last changed.",
+		Computed:    true,
 	},
 	"locked": schema.BoolAttribute{
-		Optional: true,
-		Computed: true,
+		Description: "Whether the thread is locked. Only members with MANAGE_THREADS can unarchive a locked thread.",
+		Computed:    true,
 	},
 	"invitable": schema.BoolAttribute{
-		Optional: true,
-		Computed: true,
-	},
-	"create_timestamp": schema.StringAttribute{
-		Computed: true,
+		Description: "Whether non-moderators can add other non-moderators to the thread. Only available on private threads.",
+		Computed:    true,
 	},
 }
 
@@ -60,11 +67,68 @@ type ThreadMember struct {
 	UserID types.String `tfsdk:"user_id"`
 
 	// Time the user last joined the thread
-	JoinTimestamp *time.Time `tfsdk:"join_timestamp"`
+	JoinTimestamp types.String `tfsdk:"join_timestamp"`
 
 	// Any user-thread settings, currently only used for notifications
-	Flags types.String `tfsdk:"flags"`
+	Flags types.Int32 `tfsdk:"flags"`
 
 	// Additional information about the user
 	// Member *User `tfsdk:"member"`
 }
+
+var ThreadMemberAttrTypes = map[string]attr.Type{
+	"id":             types.StringType,
+	"user_id":        types.StringType,
+	"join_timestamp": types.StringType,
+	"flags":          types.Int32Type,
+}
+
+var ThreadMemberSchema = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "The ID of the thread.",
+		Computed:    true,
+	},
+	"user_id": schema.StringAttribute{
+		Description: "The ID of the user.",
+		Computed:    true,
+	},
+	"join_timestamp": schema.StringAttribute{
+		Description: "The timestamp when the user last joined the thread.",
+		Computed:    true,
+	},
+	"flags": schema.Int32Attribute{
+		Description: "The user-thread settings, currently only used for notifications.",
+		Computed:    true,
+	},
+}
+
+// ToThreadMetadata converts the Discord thread metadata to the schema data.
+// A null object is returned when the channel is not a thread.
+func ToThreadMetadata(metadata *discordgo.ThreadMetadata) (types.Object, diag.Diagnostics) {
+	if metadata == nil {
+		return types.ObjectNull(ThreadMetadataAttrTypes), nil
+	}
+
+	return types.ObjectValueFrom(context.Background(), ThreadMetadataAttrTypes, ThreadMetadata{
+		Archived:            types.BoolValue(metadata.Archived),
+		AutoArchiveDuration: types.Int32Value(int32(metadata.AutoArchiveDuration)),
+		ArchiveTimestamp:    types.StringValue(common.StrDiscordTime(&metadata.ArchiveTimestamp, "ISO8601")),
+		Locked:              types.BoolValue(metadata.Locked),
+		Invitable:           types.BoolValue(metadata.Invitable),
+	})
+}
+
+// ToThreadMember converts the Discord thread member to the schema data.
+// A null object is returned when the current user has not joined the thread.
+func ToThreadMember(member *discordgo.ThreadMember) (types.Object, diag.Diagnostics) {
+	if member == nil {
+		return types.ObjectNull(ThreadMemberAttrTypes), nil
+	}
+
+	return types.ObjectValueFrom(context.Background(), ThreadMemberAttrTypes, ThreadMember{
+		ID:            types.StringValue(member.ID),
+		UserID:        types.StringValue(member.UserID),
+		JoinTimestamp: types.StringValue(common.StrDiscordTime(&member.JoinTimestamp, "ISO8601")),
+		Flags:         types.Int32Value(int32(member.Flags)),
+	})
+}
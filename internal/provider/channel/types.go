@@ -2,6 +2,7 @@ package channel
 
 import (
 	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -10,6 +11,14 @@ type ChannelDataSource struct {
 	client *discordgo.Session
 }
 
+// ChannelLookupModel maps the data source schema data, including the attributes used only to look up the channel.
+type ChannelLookupModel struct {
+	// The index of the channel to select when several channels share the name.
+	MatchIndex types.Int32 `tfsdk:"match_index"`
+
+	ChannelDataSourceModel
+}
+
 // ChannelDataSourceModel maps the data source schema data.
 type ChannelDataSourceModel struct {
 	// The ID of the channel.
@@ -71,10 +80,10 @@ type ChannelDataSourceModel struct {
 	LastPinTimestamp types.String `tfsdk:"last_pin_timestamp"`
 
 	// Thread-specific fields not needed by other channels
-	// ThreadMetadata types.Object `tfsdk:"thread_metadata"`
+	ThreadMetadata types.Object `tfsdk:"thread_metadata"`
 
 	// Thread member object for the current user, if they have joined the thread, only included on certain API endpoints
-	// ThreadMember *ThreadMember `tfsdk:"thread_member"`
+	ThreadMember types.Object `tfsdk:"thread_member"`
 
 	// Channel flags.
 	Flags types.List `tfsdk:"flags"`
@@ -111,5 +120,130 @@ type ChannelResourceModel struct {
 	// LastUpdated is the last time the resource was updated.
 	LastUpdated types.String `tfsdk:"last_updated"`
 
+	// The permission overwrites of the channel, managed authoritatively when set.
+	PermissionOverwrites types.Set `tfsdk:"permission_overwrites"`
+
+	// Whether the permission overwrites of the channel are synced with its parent category.
+	SyncPermissionsWithParent types.Bool `tfsdk:"sync_permissions_with_parent"`
+
+	// Whether the channel is protected from being destroyed.
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+
+	// What happens to the channel when it is destroyed, either "delete" or "archive".
+	OnDestroy types.String `tfsdk:"on_destroy"`
+
+	// The ID of the category the channel is moved into when it is archived.
+	ArchiveCategoryID types.String `tfsdk:"archive_category_id"`
+
+	// The ID of the channel whose settings are copied when the channel is created.
+	SourceChannelID types.String `tfsdk:"source_channel_id"`
+
+	// What to do on create when a channel with the configured name already exists.
+	IfExists types.String `tfsdk:"if_exists"`
+
 	ChannelDataSourceModel
 }
+
+// ChannelSchema defines the schema for a channel nested in other data sources.
+var ChannelSchema = schema.NestedAttributeObject{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the channel.",
+			Computed:    true,
+		},
+		"type": schema.StringAttribute{
+			Description: "The type of the channel.",
+			Computed:    true,
+		},
+		"guild_id": schema.StringAttribute{
+			Description: "The ID of the guild.",
+			Computed:    true,
+		},
+		"position": schema.Int32Attribute{
+			Description: "The position of the channel.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "The name of the channel.",
+			Computed:    true,
+		},
+		"topic": schema.StringAttribute{
+			Description: "The topic of the channel.",
+			Computed:    true,
+		},
+		"nsfw": schema.BoolAttribute{
+			Description: "Whether the channel is marked as NSFW.",
+			Computed:    true,
+		},
+		"bitrate": schema.Int32Attribute{
+			Description: "The bitrate of the channel, if it is a voice channel.",
+			Computed:    true,
+		},
+		"user_limit": schema.Int32Attribute{
+			Description: "The user limit of the voice channel.",
+			Computed:    true,
+		},
+		"rate_limit_per_user": schema.Int32Attribute{
+			Description: "Amount of seconds a user has to wait before sending another message or creating another thread (0-21600)",
+			Computed:    true,
+		},
+		"icon": schema.StringAttribute{
+			Description: "Icon of the group DM channel.",
+			Computed:    true,
+		},
+		"owner_id": schema.StringAttribute{
+			Description: "ID of the creator of the group DM or thread",
+			Computed:    true,
+		},
+		"application_id": schema.StringAttribute{
+			Description: "ApplicationID of the DM creator Zeroed if guild channel or not a bot user",
+			Computed:    true,
+		},
+		"parent_id": schema.StringAttribute{
+			Description: "The ID of the parent category for a channel.",
+			Computed:    true,
+		},
+		"children": schema.ListAttribute{
+			Description: "The IDs of the child channels of the category, if the channel is a category.",
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+		"last_pin_timestamp": schema.StringAttribute{
+			Description: "The timestamp of the last pinned message in the channel. " +
+				"It changes when messages are pinned, for example by discord_channel_pins, and is refreshed on the next plan.",
+			Computed: true,
+		},
+		"thread_metadata": schema.SingleNestedAttribute{
+			Description: "The thread metadata, if the channel is a thread.",
+			Computed:    true,
+			Attributes:  ThreadMetadataSchema,
+		},
+		"thread_member": schema.SingleNestedAttribute{
+			Description: "The thread member of the current user, if the channel is a thread the user has joined.",
+			Computed:    true,
+			Attributes:  ThreadMemberSchema,
+		},
+		"flags": schema.ListAttribute{
+			Description: "Channel flags.",
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+		"applied_tags": schema.ListAttribute{
+			Description: "The IDs of the set of tags that have been applied to a thread in a forum channel.",
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+		"default_thread_rate_limit_per_user": schema.Int32Attribute{
+			Description: "Amount of seconds a user has to wait before sending another message in a thread (0-21600)",
+			Computed:    true,
+		},
+		"default_sort_order": schema.StringAttribute{
+			Description: "The default sort order of threads in the channel.",
+			Computed:    true,
+		},
+		"default_forum_layout": schema.StringAttribute{
+			Description: "The default layout of threads in the channel.",
+			Computed:    true,
+		},
+	},
+}
@@ -1,6 +1,10 @@
 package channel
 
 import (
+	"context"
+	"strings"
+
+	"github.com/JustARecord/go-discordutils/base/channel"
 	discord "github.com/JustARecord/go-discordutils/utils"
 	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
 	"github.com/bwmarrin/discordgo"
@@ -53,6 +57,16 @@ func UpdateModel(result *discordgo.Channel, model, state *ChannelResourceModel)
 		return diags
 	}
 
+	threadMetadata, diags := ToThreadMetadata(result.ThreadMetadata)
+	if diags.HasError() {
+		return diags
+	}
+
+	threadMember, diags := ToThreadMember(result.Member)
+	if diags.HasError() {
+		return diags
+	}
+
 	if model == nil {
 		model = &ChannelResourceModel{}
 	}
@@ -60,7 +74,7 @@ func UpdateModel(result *discordgo.Channel, model, state *ChannelResourceModel)
 	model.ID = types.StringValue(result.ID)
 	model.Type = types.StringValue(discord.Stringify(result.Type))
 	model.Position = types.Int32Value(int32(result.Position))
-	model.Name = types.StringValue(result.Name)
+	model.Name = keepName(model.Name, result)
 	model.Topic = types.StringValue(result.Topic)
 	model.NSFW = types.BoolValue(result.NSFW)
 	model.Bitrate = types.Int32Value(int32(result.Bitrate))
@@ -71,8 +85,8 @@ func UpdateModel(result *discordgo.Channel, model, state *ChannelResourceModel)
 	model.ApplicationID = types.StringValue(result.ApplicationID)
 	model.ParentID = types.StringValue(result.ParentID)
 	model.LastPinTimestamp = types.StringValue(common.StrDiscordTime(result.LastPinTimestamp, "ISO8601"))
-	// model.ThreadMetadata = types.StringValue(result.ThreadMetadata)
-	// model.ThreadMember = types.StringValue(result.ThreadMember)
+	model.ThreadMetadata = threadMetadata
+	model.ThreadMember = threadMember
 	model.Flags = flagsList
 	model.AppliedTags = appliedTags
 	// model.DefaultReactionEmoji = types.StringValue(result.DefaultReactionEmoji)
@@ -90,5 +104,242 @@ func UpdateModel(result *discordgo.Channel, model, state *ChannelResourceModel)
 	// Map the guild data to the state.
 	model.GuildID = state.GuildID
 
+	// Keep the prior name if Discord normalized it.
+	model.Name = keepName(state.Name, result)
+
+	// Permission management is driven by the plan, the current overwrites are compared on read.
+	model.PermissionOverwrites = state.PermissionOverwrites
+	model.SyncPermissionsWithParent = state.SyncPermissionsWithParent
+
+	// Destroy options only apply to Terraform, they are not stored in Discord.
+	model.DeletionProtection = state.DeletionProtection
+	model.OnDestroy = state.OnDestroy
+	model.ArchiveCategoryID = state.ArchiveCategoryID
+	model.SourceChannelID = state.SourceChannelID
+	model.IfExists = state.IfExists
+
 	return nil
 }
+
+// seedParams copies the settings of the source channel into the parameters, for every attribute that is not set in the model.
+// The permission overwrites are copied as well, unless they are managed by the model.
+func seedParams(model *ChannelResourceModel, source *discordgo.Channel, params *discordgo.ChannelEdit) {
+	if model.Topic.IsNull() || model.Topic.IsUnknown() {
+		params.Topic = source.Topic
+	}
+
+	if model.ParentID.IsNull() || model.ParentID.IsUnknown() {
+		params.ParentID = source.ParentID
+	}
+
+	nsfw := source.NSFW
+	params.NSFW = &nsfw
+	params.Bitrate = source.Bitrate
+	params.UserLimit = source.UserLimit
+
+	if source.RateLimitPerUser > 0 {
+		rateLimit := source.RateLimitPerUser
+		params.RateLimitPerUser = &rateLimit
+	}
+
+	if source.DefaultThreadRateLimitPerUser > 0 {
+		threadRateLimit := source.DefaultThreadRateLimitPerUser
+		params.DefaultThreadRateLimitPerUser = &threadRateLimit
+	}
+
+	if source.Type == discordgo.ChannelTypeGuildForum || source.Type == discordgo.ChannelTypeGuildMedia {
+		// The tags are created again on the new channel, so their IDs are not copied.
+		tags := make([]discordgo.ForumTag, 0, len(source.AvailableTags))
+		for _, t := range source.AvailableTags {
+			t.ID = ""
+			tags = append(tags, t)
+		}
+
+		reaction := source.DefaultReactionEmoji
+		layout := source.DefaultForumLayout
+
+		params.AvailableTags = &tags
+		params.DefaultReactionEmoji = &reaction
+		params.DefaultSortOrder = source.DefaultSortOrder
+		params.DefaultForumLayout = &layout
+	}
+
+	if model.PermissionOverwrites.IsNull() && !model.SyncPermissionsWithParent.ValueBool() {
+		overwrites := make([]*discordgo.PermissionOverwrite, 0, len(source.PermissionOverwrites))
+		for _, o := range source.PermissionOverwrites {
+			overwrites = append(overwrites, &discordgo.PermissionOverwrite{
+				ID:    o.ID,
+				Type:  o.Type,
+				Allow: o.Allow,
+				Deny:  o.Deny,
+			})
+		}
+
+		params.PermissionOverwrites = overwrites
+	}
+}
+
+// archivedPermissions are the permissions denied to @everyone, and removed from every other overwrite, when a channel is archived.
+const archivedPermissions = discordgo.PermissionSendMessages |
+	discordgo.PermissionSendMessagesInThreads |
+	discordgo.PermissionCreatePublicThreads |
+	discordgo.PermissionCreatePrivateThreads |
+	discordgo.PermissionAddReactions |
+	discordgo.PermissionVoiceConnect
+
+// archiveOverwrites returns the permission overwrites of an archived channel.
+// The existing overwrites are kept so the channel stays visible to the same members, but nobody can post in it anymore.
+func archiveOverwrites(guildID string, overwrites []*discordgo.PermissionOverwrite) []*discordgo.PermissionOverwrite {
+	result := make([]*discordgo.PermissionOverwrite, 0, len(overwrites)+1)
+	everyone := false
+
+	for _, o := range overwrites {
+		locked := &discordgo.PermissionOverwrite{
+			ID:    o.ID,
+			Type:  o.Type,
+			Allow: o.Allow &^ archivedPermissions,
+			Deny:  o.Deny,
+		}
+
+		// The @everyone role has the same ID as the guild
+		if o.Type == discordgo.PermissionOverwriteTypeRole && o.ID == guildID {
+			locked.Deny |= archivedPermissions
+			everyone = true
+		}
+
+		result = append(result, locked)
+	}
+
+	if !everyone {
+		result = append(result, &discordgo.PermissionOverwrite{
+			ID:   guildID,
+			Type: discordgo.PermissionOverwriteTypeRole,
+			Deny: archivedPermissions,
+		})
+	}
+
+	return result
+}
+
+// setupOverwrites returns the permission overwrites the channel should have, and whether they are managed at all.
+// When syncing with the parent, the overwrites of the parent category are copied.
+func setupOverwrites(ctx context.Context, model *ChannelResourceModel, parent *discordgo.Channel) ([]*discordgo.PermissionOverwrite, bool, diag.Diagnostics) {
+	if model.SyncPermissionsWithParent.ValueBool() && parent != nil {
+		overwrites := make([]*discordgo.PermissionOverwrite, 0, len(parent.PermissionOverwrites))
+
+		for _, o := range parent.PermissionOverwrites {
+			overwrites = append(overwrites, &discordgo.PermissionOverwrite{
+				ID:    o.ID,
+				Type:  o.Type,
+				Allow: o.Allow,
+				Deny:  o.Deny,
+			})
+		}
+
+		return overwrites, true, nil
+	}
+
+	if model.PermissionOverwrites.IsNull() || model.PermissionOverwrites.IsUnknown() {
+		return nil, false, nil
+	}
+
+	overwrites, diags := FromPermissionOverwrites(ctx, model.PermissionOverwrites)
+
+	return overwrites, true, diags
+}
+
+// ToDataSourceModel converts the provided channel and its children to the data source model.
+func ToDataSourceModel(result *discordgo.Channel, children []*discordgo.Channel) (*ChannelDataSourceModel, diag.Diagnostics) {
+	flags := discord.ListStringify(result.Flags)
+
+	flagsList, diags := common.ToListType[string, basetypes.StringType](flags)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	appliedTags, diags := common.ToListType[string, basetypes.StringType](result.AppliedTags)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	threadMetadata, diags := ToThreadMetadata(result.ThreadMetadata)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	threadMember, diags := ToThreadMember(result.Member)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	childrenIDs := channel.Names(children)
+	childrenList, diags := common.ToListType[string, basetypes.StringType](childrenIDs)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &ChannelDataSourceModel{
+		ID:                            types.StringValue(result.ID),
+		Type:                          types.StringValue(discord.Stringify(result.Type)),
+		GuildID:                       types.StringValue(result.GuildID),
+		Position:                      types.Int32Value(int32(result.Position)),
+		Name:                          types.StringValue(result.Name),
+		Topic:                         types.StringValue(result.Topic),
+		NSFW:                          types.BoolValue(result.NSFW),
+		Bitrate:                       types.Int32Value(int32(result.Bitrate)),
+		UserLimit:                     types.Int32Value(int32(result.UserLimit)),
+		RateLimitPerUser:              types.Int32Value(int32(result.RateLimitPerUser)),
+		Icon:                          types.StringValue(result.Icon),
+		OwnerID:                       types.StringValue(result.OwnerID),
+		ApplicationID:                 types.StringValue(result.ApplicationID),
+		ParentID:                      types.StringValue(result.ParentID),
+		Children:                      childrenList,
+		LastPinTimestamp:              types.StringValue(common.StrDiscordTime(result.LastPinTimestamp, "ISO8601")),
+		ThreadMetadata:                threadMetadata,
+		ThreadMember:                  threadMember,
+		Flags:                         flagsList,
+		AppliedTags:                   appliedTags,
+		DefaultThreadRateLimitPerUser: types.Int32Value(int32(result.DefaultThreadRateLimitPerUser)),
+		DefaultSortOrder:              types.StringValue(discord.Stringify(result.DefaultSortOrder)),
+		DefaultForumLayout:            types.StringValue(discord.Stringify(result.DefaultForumLayout)),
+	}, nil
+}
+
+// findExisting returns the channel to adopt on create, or nil when a new channel should be created.
+// Only channels of the same type are considered, as channels of different types may share a name.
+func findExisting(ctx context.Context, client *discordgo.Session, guildID, name, channelType, ifExists string) (*discordgo.Channel, error) {
+	if ifExists != common.IfExistsAdopt && ifExists != common.IfExistsError {
+		return nil, nil
+	}
+
+	channels, err := channel.FetchAll(ctx, client, guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	// An untyped channel is created as a text channel
+	if channelType == "" {
+		channelType = discord.Stringify(discordgo.ChannelTypeGuildText)
+	}
+
+	existing := []*discordgo.Channel{}
+
+	for _, c := range channels {
+		// Discord normalizes the names of some channel types, such as "My Logs" to "my-logs"
+		if !strings.EqualFold(discord.Stringify(c.Type), channelType) || c.Name != NormalizeName(discord.Stringify(c.Type), name) {
+			continue
+		}
+
+		existing = append(existing, c)
+	}
+
+	if len(existing) == 0 {
+		return nil, nil
+	}
+
+	if ifExists == common.IfExistsError || len(existing) > 1 {
+		return nil, common.ExistingError("channel", name, channel.IDs(existing))
+	}
+
+	return existing[0], nil
+}
@@ -0,0 +1,17 @@
+package channel_follower
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+const (
+	resourceMetadataName = "channel_follower"
+	resourceMetadataType = "resource"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ChannelFollowerResource{}
+	_ resource.ResourceWithConfigure   = &ChannelFollowerResource{}
+	_ resource.ResourceWithImportState = &ChannelFollowerResource{}
+)
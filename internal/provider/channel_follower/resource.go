@@ -0,0 +1,370 @@
+package channel_follower
+
+import (
+	"context"
+	"fmt"
+
+	discord "github.com/JustARecord/go-discordutils/utils"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/webhook"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// NewChannelFollowerResource is a helper function to simplify the provider implementation.
+func NewChannelFollowerResource() resource.Resource {
+	return &ChannelFollowerResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *ChannelFollowerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + resourceMetadataName
+}
+
+// Schema defines the schema for the resource.
+func (r *ChannelFollowerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Follows an announcement channel into a target channel, so that messages published in the announcement channel are crossposted to the target channel. " +
+			"Discord creates a channel follower webhook in the target channel, which is deleted when the resource is destroyed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the follow. This is the ID of the follower webhook.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_channel_id": schema.StringAttribute{
+				Description: "The ID of the announcement channel to follow.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_guild_id": schema.StringAttribute{
+				Description: "The ID of the guild of the announcement channel.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"target_channel_id": schema.StringAttribute{
+				Description: "The ID of the channel that receives the crossposted messages.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"guild_id": schema.StringAttribute{
+				Description: "The ID of the guild of the target channel.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"webhook_id": schema.StringAttribute{
+				Description: "The ID of the channel follower webhook created in the target channel.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"webhook_name": schema.StringAttribute{
+				Description: "The name of the channel follower webhook created in the target channel.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "The last time the resource was updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ChannelFollowerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = tflog.SetField(ctx, "operation", "create")
+
+	var plan ChannelFollowerResourceModel
+
+	// Retrieve values from plan
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	required := map[string]attr.Value{
+		"source_channel_id": plan.SourceChannelID,
+		"target_channel_id": plan.TargetChannelID,
+	}
+
+	// Check for null/unknown values
+	common.CheckNonNull(required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check for required values
+	common.CheckRequired(ctx, required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the resource
+	follow, err := r.client.ChannelNewsFollow(plan.SourceChannelID.ValueString(), plan.TargetChannelID.ValueString(), discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to create %s", resourceMetadataName),
+			err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.Webhook(follow.WebhookID, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to get %s webhook", resourceMetadataName),
+			err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	source, err := webhook.FetchSource(ctx, r.client, follow.WebhookID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to get %s source", resourceMetadataName),
+			err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Reading plan %s %s: %v", resourceMetadataName, resourceMetadataType, plan))
+
+	UpdateModel(result, source, &plan, nil)
+
+	// Set the LastUpdated field to the current time.
+	plan.LastUpdated = types.StringValue(common.CurrentTime())
+
+	tflog.Info(ctx, fmt.Sprintf("Updated plan %s %s: %v", resourceMetadataName, resourceMetadataType, plan))
+
+	// Set the state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// All configurable attributes require a replacement, so only the state is updated.
+func (r *ChannelFollowerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = tflog.SetField(ctx, "operation", "update")
+
+	var plan ChannelFollowerResourceModel
+
+	// Retrieve values from plan
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the LastUpdated field to the current time.
+	plan.LastUpdated = types.StringValue(common.CurrentTime())
+
+	// Set the state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+// Deleting the follower webhook unfollows the announcement channel.
+func (r *ChannelFollowerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = tflog.SetField(ctx, "operation", "delete")
+
+	var state ChannelFollowerResourceModel
+
+	// Retrieve values from state
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	required := map[string]attr.Value{
+		"webhook_id": state.WebhookID,
+	}
+
+	// Check for null/unknown values
+	common.CheckNonNull(required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check for required values
+	common.CheckRequired(ctx, required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing resource, a webhook that was already deleted is gone
+	err := r.client.WebhookDelete(state.WebhookID.ValueString(), discordgo.WithContext(ctx))
+	if err != nil && !discord.NotFoundError(err) {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to delete %s", resourceMetadataName),
+			err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Import imports the resource and sets the Terraform state.
+func (r *ChannelFollowerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = tflog.SetField(ctx, "operation", "import")
+
+	if req.ID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <webhook_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	// Set the state, the channels are read from the follower webhook
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(req.ID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("webhook_id"), types.StringValue(req.ID))...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ChannelFollowerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = tflog.SetField(ctx, "operation", "read")
+
+	var state, provided ChannelFollowerResourceModel
+
+	// Read the configuration data into the provided struct.
+	diags := req.State.Get(ctx, &provided)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	required := map[string]attr.Value{
+		"webhook_id": provided.WebhookID,
+	}
+
+	// Check for null/unknown values
+	common.CheckNonNull(required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check for required values
+	common.CheckRequired(ctx, required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch data from the Discord client
+	result, err := r.client.Webhook(provided.WebhookID.ValueString(), discordgo.WithContext(ctx))
+	if err != nil {
+		if discord.NotFoundError(err) {
+			// The follower webhook was deleted, force a recreation and return early
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to get %s", resourceMetadataName),
+			err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if result.Type != discordgo.WebhookTypeChannelFollower {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Invalid %s", resourceMetadataName),
+			fmt.Sprintf("webhook %s is not a channel follower webhook", result.ID),
+		)
+		return
+	}
+
+	source, err := webhook.FetchSource(ctx, r.client, result.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to get %s source", resourceMetadataName),
+			err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Reading provided %s %s: %v", resourceMetadataName, resourceMetadataType, provided))
+
+	UpdateModel(result, source, &state, &provided)
+
+	tflog.Info(ctx, fmt.Sprintf("Updated state %s %s: %v", resourceMetadataName, resourceMetadataType, state))
+
+	// Revert last_updated to the plan value
+	if !provided.LastUpdated.IsNull() {
+		state.LastUpdated = provided.LastUpdated
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ChannelFollowerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Get the client from the provider data.
+	client, ok := req.ProviderData.(*discordgo.Session)
+	if !ok {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unexpected %s Configure Type", resourceMetadataType),
+			fmt.Sprintf("Expected *discordgo.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
@@ -0,0 +1,38 @@
+package channel_follower
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ChannelFollowerResource defines the resource implementation.
+type ChannelFollowerResource struct {
+	client *discordgo.Session
+}
+
+// ChannelFollowerResourceModel maps the resource schema data.
+type ChannelFollowerResourceModel struct {
+	// LastUpdated is the last time the resource was updated.
+	LastUpdated types.String `tfsdk:"last_updated"`
+
+	// The ID of the follow. This is the ID of the follower webhook.
+	ID types.String `tfsdk:"id"`
+
+	// The ID of the announcement channel to follow.
+	SourceChannelID types.String `tfsdk:"source_channel_id"`
+
+	// The ID of the guild of the announcement channel.
+	SourceGuildID types.String `tfsdk:"source_guild_id"`
+
+	// The ID of the channel that receives the crossposted messages.
+	TargetChannelID types.String `tfsdk:"target_channel_id"`
+
+	// The ID of the guild of the target channel.
+	GuildID types.String `tfsdk:"guild_id"`
+
+	// The ID of the webhook created in the target channel.
+	WebhookID types.String `tfsdk:"webhook_id"`
+
+	// The name of the webhook created in the target channel.
+	WebhookName types.String `tfsdk:"webhook_name"`
+}
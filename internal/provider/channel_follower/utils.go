@@ -0,0 +1,30 @@
+package channel_follower
+
+import (
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/webhook"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UpdateModel updates the channel follower resource model with the provided follower webhook and its source.
+func UpdateModel(result *discordgo.Webhook, source *webhook.WebhookSource, model, state *ChannelFollowerResourceModel) {
+	if model == nil {
+		model = &ChannelFollowerResourceModel{}
+	}
+
+	// Keep the configured source channel, in case Discord omits the source of the webhook.
+	if state != nil {
+		model.SourceChannelID = state.SourceChannelID
+	}
+
+	model.ID = types.StringValue(result.ID)
+	model.WebhookID = types.StringValue(result.ID)
+	model.WebhookName = types.StringValue(result.Name)
+	model.TargetChannelID = types.StringValue(result.ChannelID)
+	model.GuildID = types.StringValue(result.GuildID)
+	model.SourceGuildID = types.StringValue(source.GuildID)
+
+	if source.ChannelID != "" {
+		model.SourceChannelID = types.StringValue(source.ChannelID)
+	}
+}
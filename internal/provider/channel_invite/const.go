@@ -0,0 +1,20 @@
+package channel_invite
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+const (
+	resourceMetadataName = "channel_invite"
+	resourceMetadataType = "resource"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ChannelInviteResource{}
+	_ resource.ResourceWithConfigure   = &ChannelInviteResource{}
+	_ resource.ResourceWithImportState = &ChannelInviteResource{}
+)
+
+// defaultMaxAge is the duration in seconds Discord uses for invites when no max_age is provided.
+const defaultMaxAge = 86400
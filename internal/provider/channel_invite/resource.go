@@ -0,0 +1,400 @@
+package channel_invite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	discord "github.com/JustARecord/go-discordutils/utils"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// NewChannelInviteResource is a helper function to simplify the provider implementation.
+func NewChannelInviteResource() resource.Resource {
+	return &ChannelInviteResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *ChannelInviteResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + resourceMetadataName
+}
+
+// Schema defines the schema for the resource.
+func (r *ChannelInviteResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an invite to a channel. Invites cannot be edited, so any change recreates the invite. " +
+			"An invite that expired or was revoked is removed from the state and created again on the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the invite. This is the invite code.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				Description: "The ID of the channel the invite is for.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"guild_id": schema.StringAttribute{
+				Description: "The ID of the guild the invite is for.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_age": schema.Int32Attribute{
+				Description: "Duration of the invite in seconds before expiry, or 0 for never. Defaults to 86400 (24 hours).",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_uses": schema.Int32Attribute{
+				Description: "Maximum number of uses, or 0 for unlimited.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"temporary": schema.BoolAttribute{
+				Description: "Whether the invite only grants temporary membership.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"unique": schema.BoolAttribute{
+				Description: "Whether to always create a new invite, instead of reusing a similar one.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_type": schema.StringAttribute{
+				Description: "The type of target for a voice channel invite, either 'stream' or 'embedded_application'.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_user_id": schema.StringAttribute{
+				Description: "The ID of the user whose stream to display for a 'stream' invite.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_application_id": schema.StringAttribute{
+				Description: "The ID of the embedded application to open for an 'embedded_application' invite.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"code": schema.StringAttribute{
+				Description: "The invite code.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: "The full URL of the invite.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"uses": schema.Int32Attribute{
+				Description: "The number of times the invite has been used.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "When the invite was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "When the invite expires, empty if the invite never expires.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "The last time the resource was updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ChannelInviteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = tflog.SetField(ctx, "operation", "create")
+
+	var plan ChannelInviteResourceModel
+
+	// Retrieve values from plan
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	required := map[string]attr.Value{
+		"channel_id": plan.ChannelID,
+	}
+
+	// Check for null/unknown values
+	common.CheckNonNull(required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check for required values
+	common.CheckRequired(ctx, required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, err := setupParams(&plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Invalid %s Configuration", resourceMetadataType),
+			err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the resource
+	result, err := createInvite(ctx, r.client, plan.ChannelID.ValueString(), params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to create %s", resourceMetadataName),
+			err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Reading plan %s %s: %v", resourceMetadataName, resourceMetadataType, plan))
+
+	UpdateModel(result, &plan, nil)
+
+	// Set the LastUpdated field to the current time.
+	plan.LastUpdated = types.StringValue(common.CurrentTime())
+
+	tflog.Info(ctx, fmt.Sprintf("Updated plan %s %s: %v", resourceMetadataName, resourceMetadataType, plan))
+
+	// Set the state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// All configurable attributes require a replacement, so only the state is updated.
+func (r *ChannelInviteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = tflog.SetField(ctx, "operation", "update")
+
+	var plan ChannelInviteResourceModel
+
+	// Retrieve values from plan
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the LastUpdated field to the current time.
+	plan.LastUpdated = types.StringValue(common.CurrentTime())
+
+	// Set the state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ChannelInviteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = tflog.SetField(ctx, "operation", "delete")
+
+	var state ChannelInviteResourceModel
+
+	// Retrieve values from state
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	required := map[string]attr.Value{
+		"id": state.ID,
+	}
+
+	// Check for null/unknown values
+	common.CheckNonNull(required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check for required values
+	common.CheckRequired(ctx, required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing resource, an invite that already expired is gone
+	_, err := r.client.InviteDelete(state.ID.ValueString(), discordgo.WithContext(ctx))
+	if err != nil && !discord.NotFoundError(err) {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to delete %s", resourceMetadataName),
+			err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Import imports the resource and sets the Terraform state.
+func (r *ChannelInviteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = tflog.SetField(ctx, "operation", "import")
+
+	idParts := strings.Split(req.ID, "/")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <channel_id>/<code>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	// Set the state
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel_id"), types.StringValue(idParts[0]))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(idParts[1]))...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ChannelInviteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = tflog.SetField(ctx, "operation", "read")
+
+	var state, provided ChannelInviteResourceModel
+
+	// Read the configuration data into the provided struct.
+	diags := req.State.Get(ctx, &provided)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	required := map[string]attr.Value{
+		"channel_id": provided.ChannelID,
+		"id":         provided.ID,
+	}
+
+	// Check for null/unknown values
+	common.CheckNonNull(required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check for required values
+	common.CheckRequired(ctx, required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch data from the Discord client
+	result, err := fetchInvite(ctx, r.client, provided.ChannelID.ValueString(), provided.ID.ValueString())
+	if err != nil && !discord.NotFoundError(err) {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to get %s", resourceMetadataName),
+			err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if result == nil {
+		// The invite expired, was revoked or its channel was deleted, force a recreation and return early
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Reading provided %s %s: %v", resourceMetadataName, resourceMetadataType, provided))
+
+	UpdateModel(result, &state, &provided)
+
+	tflog.Info(ctx, fmt.Sprintf("Updated state %s %s: %v", resourceMetadataName, resourceMetadataType, state))
+
+	// Revert last_updated to the plan value
+	if !provided.LastUpdated.IsNull() {
+		state.LastUpdated = provided.LastUpdated
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ChannelInviteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Get the client from the provider data.
+	client, ok := req.ProviderData.(*discordgo.Session)
+	if !ok {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unexpected %s Configure Type", resourceMetadataType),
+			fmt.Sprintf("Expected *discordgo.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
@@ -0,0 +1,62 @@
+package channel_invite
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ChannelInviteResource defines the resource implementation.
+type ChannelInviteResource struct {
+	client *discordgo.Session
+}
+
+// ChannelInviteResourceModel maps the resource schema data.
+type ChannelInviteResourceModel struct {
+	// LastUpdated is the last time the resource was updated.
+	LastUpdated types.String `tfsdk:"last_updated"`
+
+	// The ID of the invite. This is the invite code.
+	ID types.String `tfsdk:"id"`
+
+	// The ID of the channel the invite is for.
+	ChannelID types.String `tfsdk:"channel_id"`
+
+	// The ID of the guild the invite is for.
+	GuildID types.String `tfsdk:"guild_id"`
+
+	// Duration of the invite in seconds before expiry, or 0 for never.
+	MaxAge types.Int32 `tfsdk:"max_age"`
+
+	// Maximum number of uses, or 0 for unlimited.
+	MaxUses types.Int32 `tfsdk:"max_uses"`
+
+	// Whether the invite only grants temporary membership.
+	Temporary types.Bool `tfsdk:"temporary"`
+
+	// Whether to always create a new invite, instead of reusing a similar one.
+	Unique types.Bool `tfsdk:"unique"`
+
+	// The type of target for a voice channel invite, either 'stream' or 'embedded_application'.
+	TargetType types.String `tfsdk:"target_type"`
+
+	// The ID of the user whose stream to display for a 'stream' invite.
+	TargetUserID types.String `tfsdk:"target_user_id"`
+
+	// The ID of the embedded application to open for an 'embedded_application' invite.
+	TargetApplicationID types.String `tfsdk:"target_application_id"`
+
+	// The invite code.
+	Code types.String `tfsdk:"code"`
+
+	// The full URL of the invite.
+	URL types.String `tfsdk:"url"`
+
+	// The number of times the invite has been used.
+	Uses types.Int32 `tfsdk:"uses"`
+
+	// When the invite was created.
+	CreatedAt types.String `tfsdk:"created_at"`
+
+	// When the invite expires, empty if the invite never expires.
+	ExpiresAt types.String `tfsdk:"expires_at"`
+}
@@ -0,0 +1,158 @@
+package channel_invite
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/invite"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// inviteParams holds the parameters to create an invite, including the target fields
+// that discordgo's ChannelInviteCreate does not send.
+type inviteParams struct {
+	MaxAge              int                        `json:"max_age"`
+	MaxUses             int                        `json:"max_uses"`
+	Temporary           bool                       `json:"temporary"`
+	Unique              bool                       `json:"unique"`
+	TargetType          discordgo.InviteTargetType `json:"target_type,omitempty"`
+	TargetUserID        string                     `json:"target_user_id,omitempty"`
+	TargetApplicationID string                     `json:"target_application_id,omitempty"`
+}
+
+func setupParams(model *ChannelInviteResourceModel) (*inviteParams, error) {
+	params := &inviteParams{
+		MaxAge:              defaultMaxAge,
+		MaxUses:             int(model.MaxUses.ValueInt32()),
+		Temporary:           model.Temporary.ValueBool(),
+		Unique:              model.Unique.ValueBool(),
+		TargetUserID:        model.TargetUserID.ValueString(),
+		TargetApplicationID: model.TargetApplicationID.ValueString(),
+	}
+
+	// Set optional parameters
+	if !model.MaxAge.IsNull() && !model.MaxAge.IsUnknown() {
+		params.MaxAge = int(model.MaxAge.ValueInt32())
+	}
+
+	if !model.TargetType.IsNull() {
+		targetType, ok := invite.ParseTargetType(model.TargetType.ValueString())
+		if !ok {
+			return nil, fmt.Errorf("invalid target type: %s", model.TargetType.ValueString())
+		}
+
+		params.TargetType = targetType
+	}
+
+	return params, nil
+}
+
+// createInvite creates an invite for the provided channel.
+// ChannelInviteCreate is used unless a target is set, as it does not send the target fields.
+func createInvite(ctx context.Context, client *discordgo.Session, channelID string, params *inviteParams) (*discordgo.Invite, error) {
+	if params.TargetType == 0 {
+		return client.ChannelInviteCreate(channelID, discordgo.Invite{
+			MaxAge:    params.MaxAge,
+			MaxUses:   params.MaxUses,
+			Temporary: params.Temporary,
+			Unique:    params.Unique,
+		}, discordgo.WithContext(ctx))
+	}
+
+	endpoint := discordgo.EndpointChannelInvites(channelID)
+
+	body, err := client.RequestWithBucketID("POST", endpoint, params, endpoint, discordgo.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	var result *discordgo.Invite
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// fetchInvite fetches the invite with the provided code from the invites of the channel.
+// The channel invites are used as they include the invite metadata, such as max_age and uses.
+func fetchInvite(ctx context.Context, client *discordgo.Session, channelID, code string) (*discordgo.Invite, error) {
+	invites, err := client.ChannelInvites(channelID, discordgo.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range invites {
+		if i.Code == code {
+			return i, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// optionalString returns the provided value, or keeps the prior null value if the value is empty.
+func optionalString(prior types.String, value string) types.String {
+	if value == "" && prior.IsNull() {
+		return prior
+	}
+
+	return types.StringValue(value)
+}
+
+// UpdateModel updates the channel invite resource model with the provided invite.
+func UpdateModel(result *discordgo.Invite, model, state *ChannelInviteResourceModel) {
+	if model == nil {
+		model = &ChannelInviteResourceModel{}
+	}
+
+	if state != nil {
+		// Map the configuration data to the state, as it is not returned by Discord.
+		model.ChannelID = state.ChannelID
+		model.Unique = state.Unique
+		model.TargetType = state.TargetType
+		model.TargetUserID = state.TargetUserID
+		model.TargetApplicationID = state.TargetApplicationID
+	}
+
+	model.ID = types.StringValue(result.Code)
+	model.Code = types.StringValue(result.Code)
+	model.URL = types.StringValue(invite.URLPrefix + result.Code)
+	model.MaxAge = types.Int32Value(int32(result.MaxAge))
+	model.MaxUses = types.Int32Value(int32(result.MaxUses))
+	model.Temporary = types.BoolValue(result.Temporary)
+	model.Uses = types.Int32Value(int32(result.Uses))
+	model.CreatedAt = types.StringValue(common.StrDiscordTime(&result.CreatedAt, "ISO8601"))
+
+	expiresAt := result.ExpiresAt
+	if expiresAt == nil && result.MaxAge > 0 {
+		t := result.CreatedAt.Add(time.Duration(result.MaxAge) * time.Second)
+		expiresAt = &t
+	}
+
+	model.ExpiresAt = types.StringValue(common.StrDiscordTime(expiresAt, "ISO8601"))
+
+	if result.Guild != nil {
+		model.GuildID = types.StringValue(result.Guild.ID)
+	} else if model.GuildID.IsNull() || model.GuildID.IsUnknown() {
+		model.GuildID = types.StringValue("")
+	}
+
+	if result.Channel != nil {
+		model.ChannelID = types.StringValue(result.Channel.ID)
+	}
+
+	model.TargetType = optionalString(model.TargetType, invite.TargetTypes[result.TargetType])
+
+	if result.TargetUser != nil {
+		model.TargetUserID = optionalString(model.TargetUserID, result.TargetUser.ID)
+	}
+
+	if result.TargetApplication != nil {
+		model.TargetApplicationID = optionalString(model.TargetApplicationID, result.TargetApplication.ID)
+	}
+}
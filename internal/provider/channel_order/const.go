@@ -0,0 +1,17 @@
+package channel_order
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+const (
+	resourceMetadataName = "channel_order"
+	resourceMetadataType = "resource"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ChannelOrderResource{}
+	_ resource.ResourceWithConfigure   = &ChannelOrderResource{}
+	_ resource.ResourceWithImportState = &ChannelOrderResource{}
+)
@@ -0,0 +1,337 @@
+package channel_order
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/JustARecord/go-discordutils/base/channel"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// NewChannelOrderResource is a helper function to simplify the provider implementation.
+func NewChannelOrderResource() resource.Resource {
+	return &ChannelOrderResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *ChannelOrderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + resourceMetadataName
+}
+
+// Schema defines the schema for the resource.
+func (r *ChannelOrderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the order of the channels in a category with a single reorder call. " +
+			"Channels that are not listed keep their position, and channels dragged in the Discord client are reported as drift.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the resource. This is the ID of the category, or the ID of the guild for top-level channels.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"guild_id": schema.StringAttribute{
+				Description: "The ID of the guild.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parent_id": schema.StringAttribute{
+				Description: "The ID of the category whose channels are ordered. Omit to order the top-level channels.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"channel_ids": schema.ListAttribute{
+				Description: "The IDs of the channels, in the order they should appear.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "The last time the resource was updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// apply reorders the channels of the plan in a single call.
+func (r *ChannelOrderResource) apply(ctx context.Context, plan *ChannelOrderResourceModel) error {
+	channelIDs, diags := common.FromListType(ctx, plan.ChannelIDs)
+	if diags.HasError() {
+		return fmt.Errorf("failed to read channel_ids")
+	}
+
+	guild_id := plan.GuildID.ValueString()
+
+	channels, err := channel.FetchAll(ctx, r.client, guild_id)
+	if err != nil {
+		return err
+	}
+
+	reorder, err := setupParams(channels, plan.ParentID.ValueString(), channelIDs)
+	if err != nil {
+		return err
+	}
+
+	if len(reorder) == 0 {
+		return nil
+	}
+
+	return r.client.GuildChannelsReorder(guild_id, reorder, discordgo.WithContext(ctx))
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ChannelOrderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = tflog.SetField(ctx, "operation", "create")
+
+	var plan ChannelOrderResourceModel
+
+	// Retrieve values from plan
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	required := map[string]attr.Value{
+		"guild_id":    plan.GuildID,
+		"channel_ids": plan.ChannelIDs,
+	}
+
+	// Check for null/unknown values
+	common.CheckNonNull(required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check for required values
+	common.CheckRequired(ctx, required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the resource
+	if err := r.apply(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to create %s", resourceMetadataName),
+			err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(resourceID(&plan))
+
+	// Set the LastUpdated field to the current time.
+	plan.LastUpdated = types.StringValue(common.CurrentTime())
+
+	tflog.Info(ctx, fmt.Sprintf("Updated plan %s %s: %v", resourceMetadataName, resourceMetadataType, plan))
+
+	// Set the state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ChannelOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = tflog.SetField(ctx, "operation", "update")
+
+	var plan ChannelOrderResourceModel
+
+	// Retrieve values from plan
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	required := map[string]attr.Value{
+		"guild_id":    plan.GuildID,
+		"channel_ids": plan.ChannelIDs,
+	}
+
+	// Check for null/unknown values
+	common.CheckNonNull(required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check for required values
+	common.CheckRequired(ctx, required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update the resource
+	if err := r.apply(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to update %s", resourceMetadataName),
+			err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(resourceID(&plan))
+
+	// Set the LastUpdated field to the current time.
+	plan.LastUpdated = types.StringValue(common.CurrentTime())
+
+	tflog.Info(ctx, fmt.Sprintf("Updated plan %s %s: %v", resourceMetadataName, resourceMetadataType, plan))
+
+	// Set the state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the resource from the Terraform state. The channels keep their current order.
+func (r *ChannelOrderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = tflog.SetField(ctx, "operation", "delete")
+
+	tflog.Info(ctx, fmt.Sprintf("Removing %s %s from state, channel positions are left unchanged", resourceMetadataName, resourceMetadataType))
+}
+
+// Import imports the resource and sets the Terraform state.
+func (r *ChannelOrderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = tflog.SetField(ctx, "operation", "import")
+
+	idParts := strings.Split(req.ID, "/")
+
+	if len(idParts) > 2 || idParts[0] == "" || (len(idParts) == 2 && idParts[1] == "") {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <guild_id>[/<parent_id>]. Got: %q", req.ID),
+		)
+		return
+	}
+
+	// Set the state
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("guild_id"), types.StringValue(idParts[0]))...)
+
+	if len(idParts) == 2 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("parent_id"), types.StringValue(idParts[1]))...)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ChannelOrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = tflog.SetField(ctx, "operation", "read")
+
+	var state, provided ChannelOrderResourceModel
+
+	// Read the configuration data into the provided struct.
+	diags := req.State.Get(ctx, &provided)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	required := map[string]attr.Value{
+		"guild_id": provided.GuildID,
+	}
+
+	// Check for null/unknown values
+	common.CheckNonNull(required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check for required values
+	common.CheckRequired(ctx, required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only report the channels managed by this resource. When importing, all channels are reported.
+	var managed []string
+	if !provided.ChannelIDs.IsNull() {
+		managed, diags = common.FromListType(ctx, provided.ChannelIDs)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch data from the Discord client
+	channels, err := channel.FetchAll(ctx, r.client, provided.GuildID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to get %s", resourceMetadataName),
+			err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Reading provided %s %s: %v", resourceMetadataName, resourceMetadataType, provided))
+
+	if diags := UpdateModel(channels, &state, &provided, managed); diags != nil {
+		resp.Diagnostics.Append(diags...)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Updated state %s %s: %v", resourceMetadataName, resourceMetadataType, state))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Revert last_updated to the plan value
+	if !provided.LastUpdated.IsNull() {
+		state.LastUpdated = provided.LastUpdated
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ChannelOrderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Get the client from the provider data.
+	client, ok := req.ProviderData.(*discordgo.Session)
+	if !ok {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unexpected %s Configure Type", resourceMetadataType),
+			fmt.Sprintf("Expected *discordgo.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
@@ -0,0 +1,29 @@
+package channel_order
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ChannelOrderResource defines the resource implementation.
+type ChannelOrderResource struct {
+	client *discordgo.Session
+}
+
+// ChannelOrderResourceModel maps the resource schema data.
+type ChannelOrderResourceModel struct {
+	// LastUpdated is the last time the resource was updated.
+	LastUpdated types.String `tfsdk:"last_updated"`
+
+	// The ID of the resource. This is the ID of the category, or the ID of the guild for top-level channels.
+	ID types.String `tfsdk:"id"`
+
+	// GuildID is the ID of the guild.
+	GuildID types.String `tfsdk:"guild_id"`
+
+	// The ID of the category whose channels are ordered. Null for top-level channels.
+	ParentID types.String `tfsdk:"parent_id"`
+
+	// The IDs of the channels, in the order they should appear.
+	ChannelIDs types.List `tfsdk:"channel_ids"`
+}
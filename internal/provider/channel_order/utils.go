@@ -0,0 +1,139 @@
+package channel_order
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// children returns the channels of the guild that are direct children of the provided parent,
+// sorted the same way the Discord client displays them.
+func children(channels []*discordgo.Channel, parentID string) []*discordgo.Channel {
+	result := []*discordgo.Channel{}
+
+	for _, c := range channels {
+		if c.ParentID == parentID {
+			result = append(result, c)
+		}
+	}
+
+	slices.SortStableFunc(result, func(a, b *discordgo.Channel) int {
+		if a.Position != b.Position {
+			return a.Position - b.Position
+		}
+
+		// Discord breaks ties between equal positions by ID
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return result
+}
+
+// setupParams validates the desired order and returns the channels to send in the reorder call.
+// The listed channels are placed in the slots they currently occupy, so channels that are not listed keep their place.
+func setupParams(channels []*discordgo.Channel, parentID string, channelIDs []string) ([]*discordgo.Channel, error) {
+	siblings := children(channels, parentID)
+	slots := []int{}
+
+	for i, id := range channelIDs {
+		if slices.Contains(channelIDs[:i], id) {
+			return nil, fmt.Errorf("channel %s is listed more than once", id)
+		}
+
+		idx := slices.IndexFunc(channels, func(c *discordgo.Channel) bool {
+			return c.ID == id
+		})
+
+		if idx == -1 {
+			return nil, fmt.Errorf("channel not found: id=%s", id)
+		}
+
+		if channels[idx].ParentID != parentID {
+			if parentID == "" {
+				return nil, fmt.Errorf("channel %s is not a top-level channel", id)
+			}
+
+			return nil, fmt.Errorf("channel %s is not a child of category %s", id, parentID)
+		}
+
+		slots = append(slots, slices.Index(siblings, channels[idx]))
+	}
+
+	slices.Sort(slots)
+
+	// Fill the slots from the top, in the listed order
+	order := slices.Clone(siblings)
+	for i, slot := range slots {
+		order[slot] = channels[slices.IndexFunc(channels, func(c *discordgo.Channel) bool {
+			return c.ID == channelIDs[i]
+		})]
+	}
+
+	reorder := []*discordgo.Channel{}
+
+	for i, c := range order {
+		if c.Position != i {
+			reorder = append(reorder, &discordgo.Channel{
+				ID:       c.ID,
+				Position: i,
+			})
+		}
+	}
+
+	return reorder, nil
+}
+
+// UpdateModel updates the channel order resource model with the current order of the channels.
+// Only channels that are managed by the model are reported, unless the model does not list any channels yet (import).
+func UpdateModel(channels []*discordgo.Channel, model, state *ChannelOrderResourceModel, managed []string) diag.Diagnostics {
+	if model == nil {
+		model = &ChannelOrderResourceModel{}
+	}
+
+	parentID := ""
+	if state != nil {
+		parentID = state.ParentID.ValueString()
+	}
+
+	ids := []string{}
+
+	for _, c := range children(channels, parentID) {
+		if managed == nil || slices.Contains(managed, c.ID) {
+			ids = append(ids, c.ID)
+		}
+	}
+
+	channelIDs, diags := common.ToListType[string, basetypes.StringType](ids)
+	if diags.HasError() {
+		return diags
+	}
+
+	model.ChannelIDs = channelIDs
+
+	if state == nil {
+		// If the plan is nil, return early.
+		return nil
+	}
+
+	// Otherwise, update the model with additional data from the plan.
+	model.GuildID = state.GuildID
+	model.ParentID = state.ParentID
+	model.ID = types.StringValue(resourceID(state))
+
+	return nil
+}
+
+// resourceID returns the ID of the resource.
+func resourceID(model *ChannelOrderResourceModel) string {
+	if !model.ParentID.IsNull() && model.ParentID.ValueString() != "" {
+		return model.ParentID.ValueString()
+	}
+
+	return model.GuildID.ValueString()
+}
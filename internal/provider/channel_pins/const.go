@@ -0,0 +1,21 @@
+package channel_pins
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+const (
+	resourceMetadataName = "channel_pins"
+	resourceMetadataType = "resource"
+
+	// maxPins is the maximum number of pinned messages in a channel.
+	maxPins = 50
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &ChannelPinsResource{}
+	_ resource.ResourceWithConfigure      = &ChannelPinsResource{}
+	_ resource.ResourceWithImportState    = &ChannelPinsResource{}
+	_ resource.ResourceWithValidateConfig = &ChannelPinsResource{}
+)
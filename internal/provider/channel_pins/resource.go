@@ -0,0 +1,389 @@
+package channel_pins
+
+import (
+	"context"
+	"fmt"
+
+	discord "github.com/JustARecord/go-discordutils/utils"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// NewChannelPinsResource is a helper function to simplify the provider implementation.
+func NewChannelPinsResource() resource.Resource {
+	return &ChannelPinsResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *ChannelPinsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + resourceMetadataName
+}
+
+// Schema defines the schema for the resource.
+func (r *ChannelPinsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Authoritatively manages the pinned messages of a channel. Messages pinned outside of Terraform are unpinned on the next apply. " +
+			"Do not use together with the pinned attribute of discord_message for the same channel.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the resource. This is the ID of the channel.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				Description: "The ID of the channel. Changing this recreates the resource.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"message_ids": schema.SetAttribute{
+				Description: fmt.Sprintf("The IDs of the messages to pin in the channel, at most %d. An empty set unpins all messages.", maxPins),
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"last_pin_timestamp": schema.StringAttribute{
+				Description: "The timestamp of the last pinned message in the channel.",
+				Computed:    true,
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "The last time the resource was updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the resource configuration.
+func (r *ChannelPinsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ChannelPinsResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.MessageIDs.IsNull() || config.MessageIDs.IsUnknown() {
+		return
+	}
+
+	if len(config.MessageIDs.Elements()) > maxPins {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("message_ids"),
+			fmt.Sprintf("Invalid %s Configuration", resourceMetadataType),
+			fmt.Sprintf("A channel can have at most %d pinned messages, got: %d.", maxPins, len(config.MessageIDs.Elements())),
+		)
+	}
+}
+
+// apply pins and unpins the messages of the channel to match the plan, then updates the plan.
+func (r *ChannelPinsResource) apply(ctx context.Context, plan *ChannelPinsResourceModel) diag.Diagnostics {
+	desired, diags := fromSet(ctx, plan.MessageIDs)
+	if diags.HasError() {
+		return diags
+	}
+
+	channelID := plan.ChannelID.ValueString()
+
+	pinned, err := r.client.ChannelMessagesPinned(channelID, discordgo.WithContext(ctx))
+	if err != nil {
+		return errorDiagnostics("Failed to get pins", err)
+	}
+
+	pin, unpin := diffPins(pinned, desired)
+
+	// Unpin first, so that the pin limit is not reached while replacing pins
+	for _, id := range unpin {
+		if err := r.client.ChannelMessageUnpin(channelID, id, discordgo.WithContext(ctx)); err != nil && !discord.NotFoundError(err) {
+			return errorDiagnostics(fmt.Sprintf("Failed to unpin message %s", id), err)
+		}
+	}
+
+	for _, id := range pin {
+		if err := r.client.ChannelMessagePin(channelID, id, discordgo.WithContext(ctx)); err != nil {
+			return errorDiagnostics(fmt.Sprintf("Failed to pin message %s", id), err)
+		}
+	}
+
+	return r.refresh(ctx, plan)
+}
+
+// refresh reads the channel and its pinned messages into the model.
+func (r *ChannelPinsResource) refresh(ctx context.Context, model *ChannelPinsResourceModel) diag.Diagnostics {
+	channelID := model.ChannelID.ValueString()
+
+	channel, err := r.client.Channel(channelID, discordgo.WithContext(ctx))
+	if err != nil {
+		return errorDiagnostics("Failed to get channel", err)
+	}
+
+	pinned, err := r.client.ChannelMessagesPinned(channelID, discordgo.WithContext(ctx))
+	if err != nil {
+		return errorDiagnostics("Failed to get pins", err)
+	}
+
+	return UpdateModel(ctx, channel, pinned, model)
+}
+
+// errorDiagnostics returns the error as diagnostics for the resource.
+func errorDiagnostics(summary string, err error) diag.Diagnostics {
+	return diag.Diagnostics{
+		diag.NewErrorDiagnostic(fmt.Sprintf("%s for %s", summary, resourceMetadataName), err.Error()),
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ChannelPinsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = tflog.SetField(ctx, "operation", "create")
+
+	var plan ChannelPinsResourceModel
+
+	// Retrieve values from plan
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	required := map[string]attr.Value{
+		"channel_id":  plan.ChannelID,
+		"message_ids": plan.MessageIDs,
+	}
+
+	// Check for null/unknown values
+	common.CheckNonNull(required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Reading plan %s %s: %v", resourceMetadataName, resourceMetadataType, plan))
+
+	// Create the resource
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the LastUpdated field to the current time.
+	plan.LastUpdated = types.StringValue(common.CurrentTime())
+
+	tflog.Info(ctx, fmt.Sprintf("Updated plan %s %s: %v", resourceMetadataName, resourceMetadataType, plan))
+
+	// Set the state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ChannelPinsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = tflog.SetField(ctx, "operation", "update")
+
+	var plan ChannelPinsResourceModel
+
+	// Retrieve values from plan
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	required := map[string]attr.Value{
+		"channel_id":  plan.ChannelID,
+		"message_ids": plan.MessageIDs,
+	}
+
+	// Check for null/unknown values
+	common.CheckNonNull(required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Reading plan %s %s: %v", resourceMetadataName, resourceMetadataType, plan))
+
+	// Update the resource
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the LastUpdated field to the current time.
+	plan.LastUpdated = types.StringValue(common.CurrentTime())
+
+	tflog.Info(ctx, fmt.Sprintf("Updated plan %s %s: %v", resourceMetadataName, resourceMetadataType, plan))
+
+	// Set the state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete unpins the managed messages and removes the Terraform state on success.
+func (r *ChannelPinsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = tflog.SetField(ctx, "operation", "delete")
+
+	var state ChannelPinsResourceModel
+
+	// Retrieve values from state
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	messageIDs, diags := fromSet(ctx, state.MessageIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unpin the messages, ignoring messages or channels that are already gone
+	for _, id := range messageIDs {
+		err := r.client.ChannelMessageUnpin(state.ChannelID.ValueString(), id, discordgo.WithContext(ctx))
+		if err != nil && !discord.NotFoundError(err) {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to delete %s", resourceMetadataName),
+				err.Error(),
+			)
+
+			return
+		}
+	}
+}
+
+// Import imports the resource and sets the Terraform state.
+func (r *ChannelPinsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = tflog.SetField(ctx, "operation", "import")
+
+	if req.ID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <channel_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	// Set the state
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel_id"), types.StringValue(req.ID))...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ChannelPinsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = tflog.SetField(ctx, "operation", "read")
+
+	var state, provided ChannelPinsResourceModel
+
+	// Read the configuration data into the provided struct.
+	diags := req.State.Get(ctx, &provided)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	required := map[string]attr.Value{
+		"channel_id": provided.ChannelID,
+	}
+
+	// Check for null/unknown values
+	common.CheckNonNull(required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check for required values
+	common.CheckRequired(ctx, required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channelID := provided.ChannelID.ValueString()
+
+	// Fetch data from the Discord client
+	channel, err := r.client.Channel(channelID, discordgo.WithContext(ctx))
+	if err != nil {
+		if discord.NotFoundError(err) {
+			// The channel was deleted, so the pins are gone too
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to get %s", resourceMetadataName),
+			err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pinned, err := r.client.ChannelMessagesPinned(channelID, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to get %s", resourceMetadataName),
+			err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Reading provided %s %s: %v", resourceMetadataName, resourceMetadataType, provided))
+
+	resp.Diagnostics.Append(UpdateModel(ctx, channel, pinned, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Updated state %s %s: %v", resourceMetadataName, resourceMetadataType, state))
+
+	// Revert last_updated to the plan value
+	if !provided.LastUpdated.IsNull() {
+		state.LastUpdated = provided.LastUpdated
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ChannelPinsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Get the client from the provider data.
+	client, ok := req.ProviderData.(*discordgo.Session)
+	if !ok {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unexpected %s Configure Type", resourceMetadataType),
+			fmt.Sprintf("Expected *discordgo.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
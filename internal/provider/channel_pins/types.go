@@ -0,0 +1,29 @@
+package channel_pins
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ChannelPinsResource defines the resource implementation.
+type ChannelPinsResource struct {
+	client *discordgo.Session
+}
+
+// ChannelPinsResourceModel maps the resource schema data.
+type ChannelPinsResourceModel struct {
+	// LastUpdated is the last time the resource was updated.
+	LastUpdated types.String `tfsdk:"last_updated"`
+
+	// The ID of the resource. This is the ID of the channel.
+	ID types.String `tfsdk:"id"`
+
+	// The ID of the channel.
+	ChannelID types.String `tfsdk:"channel_id"`
+
+	// The IDs of the messages that are pinned in the channel.
+	MessageIDs types.Set `tfsdk:"message_ids"`
+
+	// The timestamp of the last pinned message in the channel.
+	LastPinTimestamp types.String `tfsdk:"last_pin_timestamp"`
+}
@@ -0,0 +1,61 @@
+package channel_pins
+
+import (
+	"context"
+	"slices"
+
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// fromSet converts the message IDs of the model to a list of strings.
+func fromSet(ctx context.Context, set types.Set) ([]string, diag.Diagnostics) {
+	elements := make([]types.String, 0, len(set.Elements()))
+
+	diags := set.ElementsAs(ctx, &elements, false)
+
+	return common.FromStringList(elements), diags
+}
+
+// diffPins returns the messages to pin and to unpin to reach the desired pins.
+func diffPins(pinned []*discordgo.Message, desired []string) (pin, unpin []string) {
+	current := make([]string, 0, len(pinned))
+
+	for _, m := range pinned {
+		current = append(current, m.ID)
+
+		if !slices.Contains(desired, m.ID) {
+			unpin = append(unpin, m.ID)
+		}
+	}
+
+	for _, id := range desired {
+		if !slices.Contains(current, id) {
+			pin = append(pin, id)
+		}
+	}
+
+	return pin, unpin
+}
+
+// UpdateModel updates the channel pins resource model with the pinned messages of the channel.
+func UpdateModel(ctx context.Context, channel *discordgo.Channel, pinned []*discordgo.Message, model *ChannelPinsResourceModel) diag.Diagnostics {
+	ids := make([]string, 0, len(pinned))
+	for _, m := range pinned {
+		ids = append(ids, m.ID)
+	}
+
+	messageIDs, diags := types.SetValueFrom(ctx, types.StringType, ids)
+	if diags.HasError() {
+		return diags
+	}
+
+	model.ID = types.StringValue(channel.ID)
+	model.ChannelID = types.StringValue(channel.ID)
+	model.MessageIDs = messageIDs
+	model.LastPinTimestamp = types.StringValue(common.StrDiscordTime(channel.LastPinTimestamp, "ISO8601"))
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package channel_retention
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+const (
+	resourceMetadataName = "channel_retention"
+	resourceMetadataType = "resource"
+
+	// pageSize is the maximum number of messages Discord returns per request.
+	pageSize = 100
+
+	// bulkDeleteMaxAge is the maximum age of messages that can be bulk deleted.
+	// Discord rejects messages older than 14 days, an hour of margin is kept for slow applies.
+	bulkDeleteMaxAge = 14*24*time.Hour - time.Hour
+
+	// discordEpoch is the first second of 2015 in milliseconds, the epoch of Discord snowflakes.
+	discordEpoch = 1420070400000
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &ChannelRetentionResource{}
+	_ resource.ResourceWithConfigure      = &ChannelRetentionResource{}
+	_ resource.ResourceWithImportState    = &ChannelRetentionResource{}
+	_ resource.ResourceWithValidateConfig = &ChannelRetentionResource{}
+	_ resource.ResourceWithModifyPlan     = &ChannelRetentionResource{}
+)
@@ -0,0 +1,390 @@
+package channel_retention
+
+import (
+	"context"
+	"fmt"
+
+	discord "github.com/JustARecord/go-discordutils/utils"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// NewChannelRetentionResource is a helper function to simplify the provider implementation.
+func NewChannelRetentionResource() resource.Resource {
+	return &ChannelRetentionResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *ChannelRetentionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + resourceMetadataName
+}
+
+// Schema defines the schema for the resource.
+func (r *ChannelRetentionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Enforces a message retention policy on a channel. Messages older than max_age, or beyond the newest max_messages, " +
+			"are reported by expired_count on refresh and deleted on the next apply. Messages newer than 14 days are bulk deleted, older messages are deleted one by one. " +
+			"Destroying the resource stops enforcing the policy, deleted messages are not restored.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the resource. This is the ID of the channel.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				Description: "The ID of the channel. Changing this recreates the resource.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"max_age": schema.Int32Attribute{
+				Description: "The maximum age of messages in seconds. Older messages are deleted.",
+				Optional:    true,
+			},
+			"max_messages": schema.Int32Attribute{
+				Description: "The maximum number of messages to keep. Messages beyond the newest max_messages are deleted.",
+				Optional:    true,
+			},
+			"keep_pinned": schema.BoolAttribute{
+				Description: "Whether pinned messages are kept. Pinned messages do not count towards max_messages. Defaults to true.",
+				Optional:    true,
+			},
+			"expired_count": schema.Int32Attribute{
+				Description: fmt.Sprintf("The number of messages that violate the retention policy and are deleted on the next apply. "+
+					"Refreshes stop counting at %d expired messages, so that the whole history of the channel is only listed on apply.", pageSize),
+				Computed: true,
+			},
+			"deleted_count": schema.Int32Attribute{
+				Description: "The number of messages deleted by the last apply.",
+				Computed:    true,
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "The last time the resource was updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the resource configuration.
+func (r *ChannelRetentionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ChannelRetentionResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.MaxAge.IsNull() && config.MaxMessages.IsNull() {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Invalid %s Configuration", resourceMetadataType),
+			"At least one of max_age or max_messages must be set.",
+		)
+	}
+
+	if !config.MaxAge.IsNull() && !config.MaxAge.IsUnknown() && config.MaxAge.ValueInt32() <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_age"),
+			fmt.Sprintf("Invalid %s Configuration", resourceMetadataType),
+			fmt.Sprintf("max_age must be greater than 0, got: %d.", config.MaxAge.ValueInt32()),
+		)
+	}
+
+	if !config.MaxMessages.IsNull() && !config.MaxMessages.IsUnknown() && config.MaxMessages.ValueInt32() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_messages"),
+			fmt.Sprintf("Invalid %s Configuration", resourceMetadataType),
+			fmt.Sprintf("max_messages must not be negative, got: %d.", config.MaxMessages.ValueInt32()),
+		)
+	}
+}
+
+// ModifyPlan plans an update when the last refresh found expired messages, so that they are deleted on apply.
+func (r *ChannelRetentionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the resource is created or destroyed
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan ChannelRetentionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ExpiredCount.ValueInt32() == 0 {
+		return
+	}
+
+	plan.ExpiredCount = types.Int32Value(0)
+	plan.DeletedCount = types.Int32Unknown()
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// apply deletes the messages that violate the retention policy of the plan.
+// When only some of the messages could be deleted, a warning is returned so that the deleted count is still saved.
+func (r *ChannelRetentionResource) apply(ctx context.Context, plan *ChannelRetentionResourceModel) diag.Diagnostics {
+	channelID := plan.ChannelID.ValueString()
+
+	expired, err := expiredMessages(ctx, r.client, channelID, setupPolicy(plan), -1)
+	if err != nil {
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic(fmt.Sprintf("Failed to get expired messages for %s", resourceMetadataName), err.Error()),
+		}
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting %d expired messages from channel %s", len(expired), channelID))
+
+	deleted, err := deleteMessages(ctx, r.client, channelID, expired)
+
+	plan.ID = plan.ChannelID
+	plan.DeletedCount = types.Int32Value(int32(deleted))
+	plan.ExpiredCount = types.Int32Value(0)
+
+	if err == nil {
+		return nil
+	}
+
+	if deleted == 0 {
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic(fmt.Sprintf("Failed to delete expired messages for %s", resourceMetadataName), err.Error()),
+		}
+	}
+
+	// The remaining messages are reported by expired_count on the next refresh
+	return diag.Diagnostics{
+		diag.NewWarningDiagnostic(
+			fmt.Sprintf("Partially deleted expired messages for %s", resourceMetadataName),
+			fmt.Sprintf("%d of %d expired messages were deleted, the remaining messages are deleted on the next apply: %s", deleted, len(expired), err.Error()),
+		),
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ChannelRetentionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = tflog.SetField(ctx, "operation", "create")
+
+	var plan ChannelRetentionResourceModel
+
+	// Retrieve values from plan
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	required := map[string]attr.Value{
+		"channel_id": plan.ChannelID,
+	}
+
+	// Check for null/unknown values
+	common.CheckNonNull(required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check for required values
+	common.CheckRequired(ctx, required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Reading plan %s %s: %v", resourceMetadataName, resourceMetadataType, plan))
+
+	// Create the resource
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the LastUpdated field to the current time.
+	plan.LastUpdated = types.StringValue(common.CurrentTime())
+
+	tflog.Info(ctx, fmt.Sprintf("Updated plan %s %s: %v", resourceMetadataName, resourceMetadataType, plan))
+
+	// Set the state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ChannelRetentionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = tflog.SetField(ctx, "operation", "update")
+
+	var plan ChannelRetentionResourceModel
+
+	// Retrieve values from plan
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	required := map[string]attr.Value{
+		"channel_id": plan.ChannelID,
+	}
+
+	// Check for null/unknown values
+	common.CheckNonNull(required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check for required values
+	common.CheckRequired(ctx, required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Reading plan %s %s: %v", resourceMetadataName, resourceMetadataType, plan))
+
+	// Update the resource
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the LastUpdated field to the current time.
+	plan.LastUpdated = types.StringValue(common.CurrentTime())
+
+	tflog.Info(ctx, fmt.Sprintf("Updated plan %s %s: %v", resourceMetadataName, resourceMetadataType, plan))
+
+	// Set the state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the resource from the Terraform state. Deleted messages are not restored.
+func (r *ChannelRetentionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = tflog.SetField(ctx, "operation", "delete")
+
+	tflog.Info(ctx, fmt.Sprintf("Removing %s %s from state, the retention policy is no longer enforced", resourceMetadataName, resourceMetadataType))
+}
+
+// Import imports the resource and sets the Terraform state.
+func (r *ChannelRetentionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = tflog.SetField(ctx, "operation", "import")
+
+	if req.ID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <channel_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	// Set the state
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel_id"), types.StringValue(req.ID))...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ChannelRetentionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = tflog.SetField(ctx, "operation", "read")
+
+	var state, provided ChannelRetentionResourceModel
+
+	// Read the configuration data into the provided struct.
+	diags := req.State.Get(ctx, &provided)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	required := map[string]attr.Value{
+		"channel_id": provided.ChannelID,
+	}
+
+	// Check for null/unknown values
+	common.CheckNonNull(required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check for required values
+	common.CheckRequired(ctx, required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch data from the Discord client, the whole history is only scanned on apply
+	expired, err := expiredMessages(ctx, r.client, provided.ChannelID.ValueString(), setupPolicy(&provided), pageSize)
+	if err != nil {
+		if discord.NotFoundError(err) {
+			// The channel was deleted, force a recreation and return early
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to get %s", resourceMetadataName),
+			err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Reading provided %s %s: %v", resourceMetadataName, resourceMetadataType, provided))
+
+	UpdateModel(expired, &state, &provided)
+
+	tflog.Info(ctx, fmt.Sprintf("Updated state %s %s: %v", resourceMetadataName, resourceMetadataType, state))
+
+	// Revert last_updated to the plan value
+	if !provided.LastUpdated.IsNull() {
+		state.LastUpdated = provided.LastUpdated
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ChannelRetentionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Get the client from the provider data.
+	client, ok := req.ProviderData.(*discordgo.Session)
+	if !ok {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unexpected %s Configure Type", resourceMetadataType),
+			fmt.Sprintf("Expected *discordgo.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
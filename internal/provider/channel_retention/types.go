@@ -0,0 +1,38 @@
+package channel_retention
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ChannelRetentionResource defines the resource implementation.
+type ChannelRetentionResource struct {
+	client *discordgo.Session
+}
+
+// ChannelRetentionResourceModel maps the resource schema data.
+type ChannelRetentionResourceModel struct {
+	// LastUpdated is the last time the resource was updated.
+	LastUpdated types.String `tfsdk:"last_updated"`
+
+	// The ID of the resource. This is the ID of the channel.
+	ID types.String `tfsdk:"id"`
+
+	// The ID of the channel.
+	ChannelID types.String `tfsdk:"channel_id"`
+
+	// The maximum age of messages in seconds.
+	MaxAge types.Int32 `tfsdk:"max_age"`
+
+	// The maximum number of messages to keep.
+	MaxMessages types.Int32 `tfsdk:"max_messages"`
+
+	// Whether pinned messages are kept.
+	KeepPinned types.Bool `tfsdk:"keep_pinned"`
+
+	// The number of messages that violate the policy and are deleted on the next apply, counted up to a page on refresh.
+	ExpiredCount types.Int32 `tfsdk:"expired_count"`
+
+	// The number of messages deleted by the last apply.
+	DeletedCount types.Int32 `tfsdk:"deleted_count"`
+}
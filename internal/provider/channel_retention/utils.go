@@ -0,0 +1,166 @@
+package channel_retention
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/JustARecord/go-discordutils/base/channel"
+	discord "github.com/JustARecord/go-discordutils/utils"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// retentionPolicy holds the retention policy of a channel.
+type retentionPolicy struct {
+	// The maximum age of messages, zero when messages are not deleted by age.
+	MaxAge time.Duration
+
+	// The maximum number of messages to keep, negative when messages are not deleted by count.
+	MaxMessages int
+
+	// Whether pinned messages are kept.
+	KeepPinned bool
+}
+
+func setupPolicy(model *ChannelRetentionResourceModel) *retentionPolicy {
+	policy := &retentionPolicy{
+		MaxMessages: -1,
+		KeepPinned:  model.KeepPinned.IsNull() || model.KeepPinned.ValueBool(),
+	}
+
+	// Set optional parameters
+	if !model.MaxAge.IsNull() {
+		policy.MaxAge = time.Duration(model.MaxAge.ValueInt32()) * time.Second
+	}
+
+	if !model.MaxMessages.IsNull() {
+		policy.MaxMessages = int(model.MaxMessages.ValueInt32())
+	}
+
+	return policy
+}
+
+// snowflake returns the smallest snowflake created at the provided time.
+func snowflake(t time.Time) string {
+	return strconv.FormatInt((t.UnixMilli()-discordEpoch)<<22, 10)
+}
+
+// expiredMessages returns the messages of the channel that violate the retention policy, newest first.
+// Listing stops once limit expired messages are found, a negative limit lists the whole history of the channel.
+func expiredMessages(ctx context.Context, client *discordgo.Session, channelID string, policy *retentionPolicy, limit int) ([]*discordgo.Message, error) {
+	result := []*discordgo.Message{}
+
+	var cutoff time.Time
+	before := ""
+
+	if policy.MaxAge > 0 {
+		cutoff = time.Now().Add(-policy.MaxAge)
+
+		// Without a count limit, only messages older than the cutoff need to be listed
+		if policy.MaxMessages < 0 {
+			before = snowflake(cutoff)
+		}
+	}
+
+	kept := 0
+
+	for {
+		messages, err := client.ChannelMessages(channelID, pageSize, before, "", "", discordgo.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, m := range messages {
+			if policy.KeepPinned && m.Pinned {
+				continue
+			}
+
+			switch {
+			case policy.MaxAge > 0 && m.Timestamp.Before(cutoff):
+				result = append(result, m)
+			case policy.MaxMessages >= 0 && kept >= policy.MaxMessages:
+				result = append(result, m)
+			default:
+				kept++
+			}
+		}
+
+		if len(messages) < pageSize || (limit >= 0 && len(result) >= limit) {
+			break
+		}
+
+		before = messages[len(messages)-1].ID
+	}
+
+	return result, nil
+}
+
+// deleteMessages deletes the provided messages and returns how many were removed.
+// Messages that are recent enough are bulk deleted, older messages are deleted one by one.
+func deleteMessages(ctx context.Context, client *discordgo.Session, channelID string, messages []*discordgo.Message) (int, error) {
+	bulk := []string{}
+	single := []string{}
+
+	for _, m := range messages {
+		if time.Since(m.Timestamp) < bulkDeleteMaxAge {
+			bulk = append(bulk, m.ID)
+		} else {
+			single = append(single, m.ID)
+		}
+	}
+
+	deleted := 0
+
+	for len(bulk) > 0 {
+		chunk := bulk[:min(len(bulk), pageSize)]
+
+		bulk = bulk[len(chunk):]
+
+		if err := channel.DeleteMessages(ctx, client, channelID, chunk); err != nil {
+			// The messages were already deleted
+			if discord.NotFoundError(err) {
+				continue
+			}
+
+			return deleted, err
+		}
+
+		deleted += len(chunk)
+	}
+
+	for _, id := range single {
+		err := channel.DeleteMessageByID(ctx, client, channelID, id)
+		if err != nil {
+			// The message was already deleted
+			if discord.NotFoundError(err) {
+				continue
+			}
+
+			return deleted, err
+		}
+
+		deleted++
+	}
+
+	return deleted, nil
+}
+
+// UpdateModel updates the channel retention resource model with the number of expired messages.
+func UpdateModel(expired []*discordgo.Message, model, state *ChannelRetentionResourceModel) {
+	if state != nil {
+		// Map the configuration data to the state, as it is not returned by Discord.
+		model.ChannelID = state.ChannelID
+		model.MaxAge = state.MaxAge
+		model.MaxMessages = state.MaxMessages
+		model.KeepPinned = state.KeepPinned
+		model.DeletedCount = state.DeletedCount
+	}
+
+	if model.DeletedCount.IsNull() || model.DeletedCount.IsUnknown() {
+		model.DeletedCount = types.Int32Value(0)
+	}
+
+	model.ID = model.ChannelID
+	model.ExpiredCount = types.Int32Value(int32(len(expired)))
+}
@@ -0,0 +1,16 @@
+package channels
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+const (
+	datasourceMetadataName = "channels"
+	datasourceMetadataType = "data source"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &ChannelsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ChannelsDataSource{}
+)
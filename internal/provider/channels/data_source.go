@@ -0,0 +1,176 @@
+package channels
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JustARecord/go-discordutils/base/channel"
+	tfchannel "github.com/TheCodedCloud/terraform-provider-discord/internal/provider/channel"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// NewChannelsDataSource is a helper function to simplify the provider implementation.
+func NewChannelsDataSource() datasource.DataSource {
+	return &ChannelsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *ChannelsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + datasourceMetadataName
+}
+
+// Schema defines the schema for the data source.
+func (d *ChannelsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"guild_id": schema.StringAttribute{
+				Description: "The ID of the guild.",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Only return channels of this type, e.g. 'GUILD_VOICE'.",
+				Optional:    true,
+			},
+			"parent_id": schema.StringAttribute{
+				Description: "Only return channels under the category with this ID.",
+				Optional:    true,
+			},
+			"category": schema.StringAttribute{
+				Description: "Only return channels under the category with this name.",
+				Optional:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only return channels whose name matches this regular expression.",
+				Optional:    true,
+			},
+			"nsfw": schema.BoolAttribute{
+				Description: "Only return channels with this NSFW setting.",
+				Optional:    true,
+			},
+			"channels": schema.ListNestedAttribute{
+				Description:  "The channels matching the filters, sorted by position.",
+				Computed:     true,
+				NestedObject: tfchannel.ChannelSchema,
+			},
+			"ids_by_name": schema.MapAttribute{
+				Description: "The IDs of the matching channels, keyed by name. When several channels share a name, the first one by position is used.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ChannelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = tflog.SetField(ctx, "operation", "read")
+
+	var state ChannelsDataSourceModel
+
+	// Read the configuration data into the state struct.
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	required := map[string]attr.Value{
+		"guild_id": state.GuildID,
+	}
+
+	// Check for null/unknown values
+	common.CheckNonNull(required, resp.Diagnostics, datasourceMetadataName, datasourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check for required values
+	common.CheckRequired(ctx, required, resp.Diagnostics, datasourceMetadataName, datasourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch data from the Discord client
+	all, err := channel.FetchAll(ctx, d.client, state.GuildID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to get %s", datasourceMetadataName),
+			err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := filterChannels(all, &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Invalid %s Configuration", datasourceMetadataType),
+			err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Channels = make([]tfchannel.ChannelDataSourceModel, 0, len(result))
+	idsByName := map[string]string{}
+
+	for _, c := range result {
+		model, diags := tfchannel.ToDataSourceModel(c, children(all, c))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state.Channels = append(state.Channels, *model)
+
+		if _, ok := idsByName[c.Name]; !ok {
+			idsByName[c.Name] = c.ID
+		}
+	}
+
+	state.IDsByName, diags = types.MapValueFrom(ctx, types.StringType, idsByName)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Read %d channels for %s %s", len(state.Channels), datasourceMetadataName, datasourceMetadataType))
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ChannelsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Get the client from the provider data.
+	client, ok := req.ProviderData.(*discordgo.Session)
+	if !ok {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unexpected %s Configure Type", datasourceMetadataType),
+			fmt.Sprintf("Expected *discordgo.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
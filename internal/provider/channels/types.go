@@ -0,0 +1,39 @@
+package channels
+
+import (
+	tfchannel "github.com/TheCodedCloud/terraform-provider-discord/internal/provider/channel"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ChannelsDataSource defines the data source implementation.
+type ChannelsDataSource struct {
+	client *discordgo.Session
+}
+
+// ChannelsDataSourceModel maps the data source schema data.
+type ChannelsDataSourceModel struct {
+	// GuildID is the ID of the guild.
+	GuildID types.String `tfsdk:"guild_id"`
+
+	// Only return channels of this type.
+	Type types.String `tfsdk:"type"`
+
+	// Only return channels under the category with this ID.
+	ParentID types.String `tfsdk:"parent_id"`
+
+	// Only return channels under the category with this name.
+	Category types.String `tfsdk:"category"`
+
+	// Only return channels whose name matches this regular expression.
+	NameRegex types.String `tfsdk:"name_regex"`
+
+	// Only return channels with this NSFW setting.
+	NSFW types.Bool `tfsdk:"nsfw"`
+
+	// The channels matching the filters, sorted by position.
+	Channels []tfchannel.ChannelDataSourceModel `tfsdk:"channels"`
+
+	// The IDs of the matching channels, keyed by name.
+	IDsByName types.Map `tfsdk:"ids_by_name"`
+}
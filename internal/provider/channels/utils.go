@@ -0,0 +1,108 @@
+package channels
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	discord "github.com/JustARecord/go-discordutils/utils"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
+	"github.com/bwmarrin/discordgo"
+)
+
+// resolveCategory returns the ID of the category with the provided name.
+// An error listing the candidate IDs is returned when several categories share the name.
+func resolveCategory(channels []*discordgo.Channel, name string) (string, error) {
+	categories := slices.DeleteFunc(slices.Clone(channels), func(c *discordgo.Channel) bool {
+		return c.Type != discordgo.ChannelTypeGuildCategory || c.Name != name
+	})
+
+	category, err := common.SelectUnique("category", name, categories, func(c *discordgo.Channel) string {
+		return c.ID
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return category.ID, nil
+}
+
+// filterChannels returns the channels matching the filters of the model, sorted by position.
+func filterChannels(channels []*discordgo.Channel, model *ChannelsDataSourceModel) ([]*discordgo.Channel, error) {
+	var nameRegex *regexp.Regexp
+
+	if !model.NameRegex.IsNull() {
+		var err error
+
+		nameRegex, err = regexp.Compile(model.NameRegex.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("invalid name_regex: %w", err)
+		}
+	}
+
+	parentIDs := []string{}
+
+	if !model.ParentID.IsNull() {
+		parentIDs = append(parentIDs, model.ParentID.ValueString())
+	}
+
+	if !model.Category.IsNull() {
+		categoryID, err := resolveCategory(channels, model.Category.ValueString())
+		if err != nil {
+			return nil, err
+		}
+
+		parentIDs = append(parentIDs, categoryID)
+	}
+
+	result := []*discordgo.Channel{}
+
+	for _, c := range channels {
+		if !model.Type.IsNull() && !strings.EqualFold(discord.Stringify(c.Type), model.Type.ValueString()) {
+			continue
+		}
+
+		// Both parent_id and category must match when set
+		if slices.ContainsFunc(parentIDs, func(id string) bool { return c.ParentID != id }) {
+			continue
+		}
+
+		if nameRegex != nil && !nameRegex.MatchString(c.Name) {
+			continue
+		}
+
+		if !model.NSFW.IsNull() && c.NSFW != model.NSFW.ValueBool() {
+			continue
+		}
+
+		result = append(result, c)
+	}
+
+	slices.SortStableFunc(result, func(a, b *discordgo.Channel) int {
+		if a.Position != b.Position {
+			return a.Position - b.Position
+		}
+
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return result, nil
+}
+
+// children returns the direct children of the provided channel.
+func children(channels []*discordgo.Channel, parent *discordgo.Channel) []*discordgo.Channel {
+	result := []*discordgo.Channel{}
+
+	if parent.Type != discordgo.ChannelTypeGuildCategory {
+		return result
+	}
+
+	for _, c := range channels {
+		if c.ParentID == parent.ID {
+			result = append(result, c)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,192 @@
+package common
+
+import (
+	discord "github.com/JustARecord/go-discordutils/utils"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Embed maps a message embed in the schema data.
+type Embed struct {
+	// The title of the embed.
+	Title types.String `tfsdk:"title"`
+
+	// The description of the embed.
+	Description types.String `tfsdk:"description"`
+
+	// The URL the title links to.
+	URL types.String `tfsdk:"url"`
+
+	// The hex color of the embed.
+	Color types.String `tfsdk:"color"`
+
+	// The ISO8601 timestamp shown in the embed footer.
+	Timestamp types.String `tfsdk:"timestamp"`
+
+	// The footer text of the embed.
+	FooterText types.String `tfsdk:"footer_text"`
+
+	// The URL of the footer icon.
+	FooterIconURL types.String `tfsdk:"footer_icon_url"`
+
+	// The URL of the embed image.
+	ImageURL types.String `tfsdk:"image_url"`
+
+	// The URL of the embed thumbnail.
+	ThumbnailURL types.String `tfsdk:"thumbnail_url"`
+
+	// The name of the embed author.
+	AuthorName types.String `tfsdk:"author_name"`
+
+	// The URL the author name links to.
+	AuthorURL types.String `tfsdk:"author_url"`
+
+	// The URL of the author icon.
+	AuthorIconURL types.String `tfsdk:"author_icon_url"`
+
+	// The fields of the embed.
+	Fields []EmbedField `tfsdk:"fields"`
+}
+
+// EmbedField maps a single embed field in the schema data.
+type EmbedField struct {
+	// The name of the field.
+	Name types.String `tfsdk:"name"`
+
+	// The value of the field.
+	Value types.String `tfsdk:"value"`
+
+	// Whether the field is displayed inline.
+	Inline types.Bool `tfsdk:"inline"`
+}
+
+var EmbedSchema = schema.NestedAttributeObject{
+	Attributes: map[string]schema.Attribute{
+		"title": schema.StringAttribute{
+			Description: "The title of the embed.",
+			Optional:    true,
+		},
+		"description": schema.StringAttribute{
+			Description: "The description of the embed.",
+			Optional:    true,
+		},
+		"url": schema.StringAttribute{
+			Description: "The URL the title links to.",
+			Optional:    true,
+		},
+		"color": schema.StringAttribute{
+			Description: "The hex color of the embed, e.g. '#5865F2'.",
+			Optional:    true,
+		},
+		"timestamp": schema.StringAttribute{
+			Description: "The ISO8601 timestamp shown in the embed footer.",
+			Optional:    true,
+		},
+		"footer_text": schema.StringAttribute{
+			Description: "The footer text of the embed.",
+			Optional:    true,
+		},
+		"footer_icon_url": schema.StringAttribute{
+			Description: "The URL of the footer icon.",
+			Optional:    true,
+		},
+		"image_url": schema.StringAttribute{
+			Description: "The URL of the embed image.",
+			Optional:    true,
+		},
+		"thumbnail_url": schema.StringAttribute{
+			Description: "The URL of the embed thumbnail.",
+			Optional:    true,
+		},
+		"author_name": schema.StringAttribute{
+			Description: "The name of the embed author.",
+			Optional:    true,
+		},
+		"author_url": schema.StringAttribute{
+			Description: "The URL the author name links to.",
+			Optional:    true,
+		},
+		"author_icon_url": schema.StringAttribute{
+			Description: "The URL of the author icon.",
+			Optional:    true,
+		},
+		"fields": schema.ListNestedAttribute{
+			Description: "The fields of the embed.",
+			Optional:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "The name of the field.",
+						Required:    true,
+					},
+					"value": schema.StringAttribute{
+						Description: "The value of the field.",
+						Required:    true,
+					},
+					"inline": schema.BoolAttribute{
+						Description: "Whether the field is displayed inline.",
+						Optional:    true,
+					},
+				},
+			},
+		},
+	},
+}
+
+// ToMessageEmbeds converts the embeds from the schema data to Discord message embeds.
+func ToMessageEmbeds(embeds []Embed) []*discordgo.MessageEmbed {
+	result := make([]*discordgo.MessageEmbed, 0, len(embeds))
+
+	for _, e := range embeds {
+		embed := &discordgo.MessageEmbed{
+			Type:        discordgo.EmbedTypeRich,
+			Title:       e.Title.ValueString(),
+			Description: e.Description.ValueString(),
+			URL:         e.URL.ValueString(),
+			Timestamp:   e.Timestamp.ValueString(),
+		}
+
+		if !e.Color.IsNull() {
+			embed.Color = IntHex(e.Color.ValueString())
+		}
+
+		if !e.FooterText.IsNull() || !e.FooterIconURL.IsNull() {
+			embed.Footer = &discordgo.MessageEmbedFooter{
+				Text:    e.FooterText.ValueString(),
+				IconURL: e.FooterIconURL.ValueString(),
+			}
+		}
+
+		if !e.ImageURL.IsNull() {
+			embed.Image = &discordgo.MessageEmbedImage{URL: e.ImageURL.ValueString()}
+		}
+
+		if !e.ThumbnailURL.IsNull() {
+			embed.Thumbnail = &discordgo.MessageEmbedThumbnail{URL: e.ThumbnailURL.ValueString()}
+		}
+
+		if !e.AuthorName.IsNull() {
+			embed.Author = &discordgo.MessageEmbedAuthor{
+				Name:    e.AuthorName.ValueString(),
+				URL:     e.AuthorURL.ValueString(),
+				IconURL: e.AuthorIconURL.ValueString(),
+			}
+		}
+
+		for _, f := range e.Fields {
+			embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+				Name:   f.Name.ValueString(),
+				Value:  f.Value.ValueString(),
+				Inline: f.Inline.ValueBool(),
+			})
+		}
+
+		// Make sure the embed stays within Discord's character limits
+		discord.TruncateEmbed(embed)
+
+		result = append(result, embed)
+	}
+
+	return result
+}
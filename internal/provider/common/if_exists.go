@@ -0,0 +1,54 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The actions that can be taken on create when an object with the configured name already exists.
+const (
+	IfExistsAdopt  = "adopt"
+	IfExistsError  = "error"
+	IfExistsCreate = "create"
+)
+
+// IfExistsDescription describes the if_exists attribute of the provided object type.
+func IfExistsDescription(object string) string {
+	return fmt.Sprintf("What to do on create when a %[1]s with the configured name already exists. "+
+		"'adopt' takes the existing %[1]s over and applies the configuration to it, 'error' fails the apply, and 'create' creates another %[1]s with the same name. "+
+		"An adopted %[1]s is deleted when the resource is destroyed. Defaults to 'create'.", object)
+}
+
+// ValidateIfExists validates the if_exists value of the configuration.
+func ValidateIfExists(value types.String, resourceType string) diag.Diagnostics {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	switch value.ValueString() {
+	case IfExistsAdopt, IfExistsError, IfExistsCreate:
+		return nil
+	}
+
+	return diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(
+			path.Root("if_exists"),
+			fmt.Sprintf("Invalid %s Configuration", resourceType),
+			fmt.Sprintf("if_exists must be one of '%s', '%s' or '%s', got: %q.", IfExistsAdopt, IfExistsError, IfExistsCreate, value.ValueString()),
+		),
+	}
+}
+
+// ExistingError returns the error reported when objects with the configured name already exist and if_exists is 'error'
+// or the object to adopt is ambiguous.
+func ExistingError(object, name string, ids []string) error {
+	if len(ids) > 1 {
+		return fmt.Errorf("%d %ss named %q already exist: %s. Import the intended %s instead", len(ids), object, name, strings.Join(ids, ", "), object)
+	}
+
+	return fmt.Errorf("a %s named %q already exists: %s. Set if_exists to '%s' to take it over, or import it", object, name, ids[0], IfExistsAdopt)
+}
@@ -0,0 +1,84 @@
+package common
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// MatchIndexDescription describes the match_index attribute used to pick between objects that share a name.
+func MatchIndexDescription(object string) string {
+	return fmt.Sprintf("The index of the %[1]s to select when several %[1]ss share the configured name, ordered from the oldest to the newest. "+
+		"Without it, a lookup by name that matches more than one %[1]s fails and lists the candidate IDs.", object)
+}
+
+// compareSnowflakes compares the IDs by their creation time, from the oldest to the newest.
+func compareSnowflakes(a, b string) int {
+	if len(a) != len(b) {
+		return len(a) - len(b)
+	}
+
+	return strings.Compare(a, b)
+}
+
+// sortMatches orders the matches of a lookup by name from the oldest to the newest, and returns their IDs.
+func sortMatches[T any](matches []T, id func(T) string) ([]T, []string) {
+	matches = slices.Clone(matches)
+	slices.SortStableFunc(matches, func(a, b T) int {
+		return compareSnowflakes(id(a), id(b))
+	})
+
+	ids := make([]string, 0, len(matches))
+	for _, m := range matches {
+		ids = append(ids, id(m))
+	}
+
+	return matches, ids
+}
+
+// SelectUnique returns the only object matching a lookup by name, such as an import by name.
+// An error listing the candidate IDs is returned when several objects match.
+func SelectUnique[T any](object, name string, matches []T, id func(T) string) (T, error) {
+	var result T
+
+	if len(matches) == 0 {
+		return result, fmt.Errorf("%s not found: name=%s", object, name)
+	}
+
+	matches, ids := sortMatches(matches, id)
+
+	if len(matches) > 1 {
+		return result, fmt.Errorf("%d %ss are named %q: %s. Use the ID of the intended %s instead", len(matches), object, name, strings.Join(ids, ", "), object)
+	}
+
+	return matches[0], nil
+}
+
+// SelectMatch returns the object matching a lookup by name. The matches are ordered from the oldest to the newest,
+// and an error listing the candidate IDs is returned when several objects match and the match index is not set.
+func SelectMatch[T any](object, name string, matches []T, id func(T) string, matchIndex types.Int32) (T, error) {
+	var result T
+
+	if len(matches) == 0 {
+		return result, fmt.Errorf("%s not found: name=%s", object, name)
+	}
+
+	matches, ids := sortMatches(matches, id)
+
+	if matchIndex.IsNull() || matchIndex.IsUnknown() {
+		if len(matches) > 1 {
+			return result, fmt.Errorf("%d %ss are named %q: %s. Use the ID of the intended %s, or set match_index to select it", len(matches), object, name, strings.Join(ids, ", "), object)
+		}
+
+		return matches[0], nil
+	}
+
+	index := int(matchIndex.ValueInt32())
+	if index < 0 || index >= len(matches) {
+		return result, fmt.Errorf("match_index %d is out of range, %d %ss are named %q: %s", index, len(matches), object, name, strings.Join(ids, ", "))
+	}
+
+	return matches[index], nil
+}
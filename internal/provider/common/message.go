@@ -0,0 +1,136 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AllowedMentions maps the allowed mentions of a message in the schema data.
+type AllowedMentions struct {
+	// The mention types to parse from the content, any of 'users', 'roles' or 'everyone'.
+	Parse []types.String `tfsdk:"parse"`
+
+	// The IDs of the roles that may be mentioned.
+	Roles []types.String `tfsdk:"roles"`
+
+	// The IDs of the users that may be mentioned.
+	Users []types.String `tfsdk:"users"`
+
+	// Whether to mention the author of the message being replied to.
+	RepliedUser types.Bool `tfsdk:"replied_user"`
+}
+
+var AllowedMentionsSchema = schema.SingleNestedAttribute{
+	Description: "The mentions that are allowed to ping in the message. When omitted, all mentions in the content are parsed.",
+	Optional:    true,
+	Attributes: map[string]schema.Attribute{
+		"parse": schema.SetAttribute{
+			Description: "The mention types to parse from the content, any of 'users', 'roles' or 'everyone'.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"roles": schema.SetAttribute{
+			Description: "The IDs of the roles that may be mentioned. Cannot be used with 'roles' in parse.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"users": schema.SetAttribute{
+			Description: "The IDs of the users that may be mentioned. Cannot be used with 'users' in parse.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"replied_user": schema.BoolAttribute{
+			Description: "Whether to mention the author of the message being replied to.",
+			Optional:    true,
+		},
+	},
+}
+
+// allowedMentionTypes are the mention types that can be parsed from the content.
+var allowedMentionTypes = []discordgo.AllowedMentionType{
+	discordgo.AllowedMentionTypeUsers,
+	discordgo.AllowedMentionTypeRoles,
+	discordgo.AllowedMentionTypeEveryone,
+}
+
+// ToAllowedMentions converts the allowed mentions from the schema data to Discord allowed mentions.
+// A nil value is returned when the allowed mentions are not set.
+func ToAllowedMentions(mentions *AllowedMentions) (*discordgo.MessageAllowedMentions, error) {
+	if mentions == nil {
+		return nil, nil
+	}
+
+	result := &discordgo.MessageAllowedMentions{
+		Parse:       []discordgo.AllowedMentionType{},
+		Roles:       FromStringList(mentions.Roles),
+		Users:       FromStringList(mentions.Users),
+		RepliedUser: mentions.RepliedUser.ValueBool(),
+	}
+
+	for _, p := range FromStringList(mentions.Parse) {
+		mentionType := discordgo.AllowedMentionType(p)
+
+		if !slices.Contains(allowedMentionTypes, mentionType) {
+			return nil, fmt.Errorf("invalid allowed mention type: %s", p)
+		}
+
+		result.Parse = append(result.Parse, mentionType)
+	}
+
+	return result, nil
+}
+
+// ToMessageComponents converts a JSON array of message components to Discord message components.
+// An empty value returns no components.
+func ToMessageComponents(value string) ([]discordgo.MessageComponent, error) {
+	result := []discordgo.MessageComponent{}
+
+	if value == "" {
+		return result, nil
+	}
+
+	var raw []json.RawMessage
+	if err := json.Unmarshal([]byte(value), &raw); err != nil {
+		return nil, fmt.Errorf("components must be a JSON array: %w", err)
+	}
+
+	for _, r := range raw {
+		component, err := discordgo.MessageComponentFromJSON(r)
+		if err != nil {
+			return nil, fmt.Errorf("invalid component: %w", err)
+		}
+
+		result = append(result, component)
+	}
+
+	return result, nil
+}
+
+// ToMessageFiles reads the files at the provided paths to upload them as message attachments.
+func ToMessageFiles(paths []string) ([]*discordgo.File, error) {
+	result := make([]*discordgo.File, 0, len(paths))
+
+	for _, p := range paths {
+		data, err := os.ReadFile(p)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read attachment: %w", err)
+		}
+
+		result = append(result, &discordgo.File{
+			Name:        filepath.Base(p),
+			ContentType: mime.TypeByExtension(filepath.Ext(p)),
+			Reader:      bytes.NewReader(data),
+		})
+	}
+
+	return result, nil
+}
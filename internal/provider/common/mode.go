@@ -0,0 +1,43 @@
+package common
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The modes of the resources that manage the members of a role, or the roles of a member.
+const (
+	// ModeAuthoritative removes anything that is not configured.
+	ModeAuthoritative = "authoritative"
+
+	// ModeAdditive only removes what the resource added.
+	ModeAdditive = "additive"
+)
+
+// IsAdditive returns whether the mode is additive. Authoritative is the default.
+func IsAdditive(value types.String) bool {
+	return value.ValueString() == ModeAdditive
+}
+
+// ValidateMode validates the mode value of the configuration.
+func ValidateMode(value types.String, resourceType string) diag.Diagnostics {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	switch value.ValueString() {
+	case ModeAuthoritative, ModeAdditive:
+		return nil
+	}
+
+	return diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(
+			path.Root("mode"),
+			fmt.Sprintf("Invalid %s Configuration", resourceType),
+			fmt.Sprintf("mode must be one of '%s' or '%s', got: %q.", ModeAuthoritative, ModeAdditive, value.ValueString()),
+		),
+	}
+}
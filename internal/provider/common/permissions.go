@@ -0,0 +1,34 @@
+package common
+
+import (
+	"fmt"
+
+	dcommon "github.com/JustARecord/go-discordutils/base/common"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ValidatePermissions validates that the configured permission names are known, as unknown names are dropped
+// when the permissions are calculated and would never match the permissions read back from Discord.
+func ValidatePermissions(attrPath path.Path, elements []attr.Value, resourceType string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, e := range elements {
+		name, ok := e.(types.String)
+		if !ok || name.IsNull() || name.IsUnknown() {
+			continue
+		}
+
+		if _, ok := dcommon.Permissions[name.ValueString()]; !ok {
+			diags.AddAttributeError(
+				attrPath,
+				fmt.Sprintf("Invalid %s Configuration", resourceType),
+				fmt.Sprintf("Unknown permission: %q.", name.ValueString()),
+			)
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,33 @@
+package common
+
+import (
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// PlanChanged reports whether the plan changes an attribute of the state, other than the ignored attributes.
+// Creating or destroying the resource is always a change.
+func PlanChanged(plan, state tftypes.Value, ignore ...string) bool {
+	if plan.IsNull() || state.IsNull() {
+		return true
+	}
+
+	var planAttrs, stateAttrs map[string]tftypes.Value
+
+	if plan.As(&planAttrs) != nil || state.As(&stateAttrs) != nil {
+		return true
+	}
+
+	for name, value := range planAttrs {
+		if slices.Contains(ignore, name) {
+			continue
+		}
+
+		if !value.Equal(stateAttrs[name]) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,18 @@
+package everyone_role
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+const (
+	resourceMetadataName = "everyone_role"
+	resourceMetadataType = "resource"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &EveryoneRoleResource{}
+	_ resource.ResourceWithConfigure      = &EveryoneRoleResource{}
+	_ resource.ResourceWithImportState    = &EveryoneRoleResource{}
+	_ resource.ResourceWithValidateConfig = &EveryoneRoleResource{}
+)
@@ -0,0 +1,35 @@
+package everyone_role
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// EveryoneRoleResource defines the resource implementation.
+type EveryoneRoleResource struct {
+	client *discordgo.Session
+}
+
+// EveryoneRoleResourceModel maps the resource schema data.
+type EveryoneRoleResourceModel struct {
+	// LastUpdated is the last time the resource was updated.
+	LastUpdated types.String `tfsdk:"last_updated"`
+
+	// The ID of the @everyone role. This is the ID of the guild.
+	ID types.String `tfsdk:"id"`
+
+	// GuildID is the ID of the guild.
+	GuildID types.String `tfsdk:"guild_id"`
+
+	// The permissions of the @everyone role.
+	Permissions types.List `tfsdk:"permissions"`
+
+	// Whether the @everyone role is mentionable.
+	Mentionable types.Bool `tfsdk:"mentionable"`
+
+	// The permissions of the @everyone role before it was managed, restored on destroy.
+	OriginalPermissions types.List `tfsdk:"original_permissions"`
+
+	// Whether the @everyone role was mentionable before it was managed, restored on destroy.
+	OriginalMentionable types.Bool `tfsdk:"original_mentionable"`
+}
@@ -0,0 +1,82 @@
+package everyone_role
+
+import (
+	"context"
+
+	discord "github.com/JustARecord/go-discordutils/utils"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// setupParams returns the role parameters for the permissions and mentionable state of the model.
+// Only the set values are sent, so the name and the other settings of the role are left unchanged.
+func setupParams(ctx context.Context, permissions types.List, mentionable types.Bool) (*discordgo.RoleParams, diag.Diagnostics) {
+	params := &discordgo.RoleParams{}
+
+	if !permissions.IsNull() && !permissions.IsUnknown() {
+		names, diags := common.FromListType(ctx, permissions)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		permissionsSum := discord.CalcPermissions(names)
+		params.Permissions = &permissionsSum
+	}
+
+	if !mentionable.IsNull() && !mentionable.IsUnknown() {
+		params.Mentionable = mentionable.ValueBoolPointer()
+	}
+
+	return params, nil
+}
+
+// toPermissionsList converts the permissions of the role to a list.
+// The prior list is kept when it holds the same permissions, so that the order of the configuration is preserved.
+func toPermissionsList(ctx context.Context, result *discordgo.Role, prior types.List) (types.List, diag.Diagnostics) {
+	permissions := discord.ListStringify(result.Permissions)
+
+	if !prior.IsNull() && !prior.IsUnknown() {
+		names, diags := common.FromListType(ctx, prior)
+		if diags.HasError() {
+			return prior, diags
+		}
+
+		if discord.CalcPermissions(names) == result.Permissions {
+			return prior, nil
+		}
+	}
+
+	return common.ToListType[string, basetypes.StringType](permissions)
+}
+
+// UpdateModel updates the everyone role resource model with the provided role.
+func UpdateModel(ctx context.Context, result *discordgo.Role, model, state *EveryoneRoleResourceModel) diag.Diagnostics {
+	if state != nil {
+		// Map the configuration data to the state, as it is not returned by Discord.
+		model.GuildID = state.GuildID
+		model.Permissions = state.Permissions
+		model.OriginalPermissions = state.OriginalPermissions
+		model.OriginalMentionable = state.OriginalMentionable
+	}
+
+	permissions, diags := toPermissionsList(ctx, result, model.Permissions)
+	if diags.HasError() {
+		return diags
+	}
+
+	model.ID = types.StringValue(result.ID)
+	model.Permissions = permissions
+	model.Mentionable = types.BoolValue(result.Mentionable)
+
+	return nil
+}
+
+// originals returns the current permissions and mentionable state of the role, to restore them on destroy.
+func originals(result *discordgo.Role) (types.List, types.Bool, diag.Diagnostics) {
+	permissions, diags := common.ToListType[string, basetypes.StringType](discord.ListStringify(result.Permissions))
+
+	return permissions, types.BoolValue(result.Mentionable), diags
+}
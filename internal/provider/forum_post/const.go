@@ -0,0 +1,18 @@
+package forum_post
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+const (
+	resourceMetadataName = "forum_post"
+	resourceMetadataType = "resource"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ForumPostResource{}
+	_ resource.ResourceWithConfigure   = &ForumPostResource{}
+	_ resource.ResourceWithImportState = &ForumPostResource{}
+	_ resource.ResourceWithModifyPlan  = &ForumPostResource{}
+)
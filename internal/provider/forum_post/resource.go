@@ -0,0 +1,539 @@
+package forum_post
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/JustARecord/go-discordutils/base/channel"
+	discord "github.com/JustARecord/go-discordutils/utils"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// NewForumPostResource is a helper function to simplify the provider implementation.
+func NewForumPostResource() resource.Resource {
+	return &ForumPostResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *ForumPostResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + resourceMetadataName
+}
+
+// Schema defines the schema for the resource.
+func (r *ForumPostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"guild_id": schema.StringAttribute{
+				Description: "The ID of the guild.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				Description: "The ID of the forum channel the post is created in. Changing this recreates the post.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "The ID of the post (thread). This is also the ID of the starter message.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The title of the post.",
+				Required:    true,
+			},
+			"content": schema.StringAttribute{
+				Description: "The content of the starter message. Changes are applied by editing the message in place.",
+				Optional:    true,
+			},
+			"embeds": schema.ListNestedAttribute{
+				Description:  "The embeds of the starter message. Changes are applied by editing the message in place.",
+				Optional:     true,
+				NestedObject: common.EmbedSchema,
+			},
+			"applied_tags": schema.ListAttribute{
+				Description: "The names of the forum tags applied to the post.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"applied_tag_ids": schema.ListAttribute{
+				Description: "The IDs of the forum tags applied to the post.",
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "The last time the resource was updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ModifyPlan marks the applied tag IDs as unknown when the applied tags change, as they are resolved on apply.
+func (r *ForumPostResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the resource is created or destroyed
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan ForumPostResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.AppliedTags.Equal(state.AppliedTags) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("applied_tag_ids"), types.ListUnknown(types.StringType))...)
+}
+
+// fetchForum fetches the forum channel and resolves the applied tag names of the model to tag IDs.
+func (r *ForumPostResource) fetchForum(ctx context.Context, model *ForumPostResourceModel) (*discordgo.Channel, []string, diag.Diagnostics) {
+	forum, err := channel.FetchByID(ctx, r.client, model.GuildID.ValueString(), model.ChannelID.ValueString())
+	if err != nil {
+		return nil, nil, diag.Diagnostics{
+			diag.NewErrorDiagnostic(fmt.Sprintf("Failed to get forum for %s", resourceMetadataName), err.Error()),
+		}
+	}
+
+	if forum.Type != discordgo.ChannelTypeGuildForum && forum.Type != discordgo.ChannelTypeGuildMedia {
+		return nil, nil, diag.Diagnostics{
+			diag.NewAttributeErrorDiagnostic(
+				path.Root("channel_id"),
+				fmt.Sprintf("Invalid %s Configuration", resourceMetadataType),
+				fmt.Sprintf("channel %s is not a forum channel", forum.ID),
+			),
+		}
+	}
+
+	tags := []string{}
+
+	if !model.AppliedTags.IsNull() {
+		names, diags := common.FromListType(ctx, model.AppliedTags)
+		if diags.HasError() {
+			return nil, nil, diags
+		}
+
+		tags, err = resolveTagIDs(forum, names)
+		if err != nil {
+			return nil, nil, diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("applied_tags"),
+					fmt.Sprintf("Invalid %s Configuration", resourceMetadataType),
+					err.Error(),
+				),
+			}
+		}
+	}
+
+	return forum, tags, nil
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ForumPostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = tflog.SetField(ctx, "operation", "create")
+
+	var plan ForumPostResourceModel
+
+	// Retrieve values from plan
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	required := map[string]attr.Value{
+		"guild_id":   plan.GuildID,
+		"channel_id": plan.ChannelID,
+		"name":       plan.Name,
+	}
+
+	// Check for null/unknown values
+	common.CheckNonNull(required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check for required values
+	common.CheckRequired(ctx, required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	forum, tags, diags := r.fetchForum(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	threadParams := &discordgo.ThreadStart{
+		Name:        plan.Name.ValueString(),
+		AppliedTags: tags,
+	}
+
+	// Create the resource
+	result, err := r.client.ForumThreadStartComplex(forum.ID, threadParams, messageContent(&plan), discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to create %s", resourceMetadataName),
+			err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The starter message shares its ID with the thread.
+	message, err := channel.FetchMessageByID(ctx, r.client, result.ID, result.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to get %s starter message", resourceMetadataName),
+			err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Reading plan %s %s: %v", resourceMetadataName, resourceMetadataType, plan))
+
+	if diags := UpdateModel(result, forum, message, &plan, nil); diags != nil {
+		resp.Diagnostics.Append(diags...)
+	}
+
+	// Set the LastUpdated field to the current time.
+	plan.LastUpdated = types.StringValue(common.CurrentTime())
+
+	tflog.Info(ctx, fmt.Sprintf("Updated plan %s %s: %v", resourceMetadataName, resourceMetadataType, plan))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ForumPostResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = tflog.SetField(ctx, "operation", "update")
+
+	var plan, state ForumPostResourceModel
+
+	// Retrieve values from plan
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	required := map[string]attr.Value{
+		"guild_id":   plan.GuildID,
+		"channel_id": plan.ChannelID,
+		"name":       plan.Name,
+		"id":         state.ID,
+	}
+
+	// Check for null/unknown values
+	common.CheckNonNull(required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check for required values
+	common.CheckRequired(ctx, required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	forum, tags, diags := r.fetchForum(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+
+	threadParams := &discordgo.ChannelEdit{
+		Name:        plan.Name.ValueString(),
+		AppliedTags: &tags,
+	}
+
+	// Update the thread
+	result, err := channel.UpdateByID(ctx, r.client, id, threadParams)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to update %s", resourceMetadataName),
+			err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Edit the starter message in place
+	message, err := channel.EditMessageByID(ctx, r.client, id, id, messageEdit(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to update %s starter message", resourceMetadataName),
+			err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Reading provided %s %s: %v", resourceMetadataName, resourceMetadataType, plan))
+	tflog.Info(ctx, fmt.Sprintf("Reading state %s %s: %v", resourceMetadataName, resourceMetadataType, state))
+
+	if diags := UpdateModel(result, forum, message, &plan, nil); diags != nil {
+		resp.Diagnostics.Append(diags...)
+	}
+
+	// Set the LastUpdated field to the current time.
+	plan.LastUpdated = types.StringValue(common.CurrentTime())
+
+	tflog.Info(ctx, fmt.Sprintf("Updated plan %s %s: %v", resourceMetadataName, resourceMetadataType, plan))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ForumPostResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = tflog.SetField(ctx, "operation", "delete")
+
+	var state ForumPostResourceModel
+
+	// Retrieve values from state
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	required := map[string]attr.Value{
+		"id": state.ID,
+	}
+
+	// Check for null/unknown values
+	common.CheckNonNull(required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check for required values
+	common.CheckRequired(ctx, required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Deleting the thread also deletes the starter message
+	err := channel.DeleteByID(ctx, r.client, state.ID.ValueString())
+	if err != nil && !discord.NotFoundError(err) {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to delete %s", resourceMetadataName),
+			err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Import imports the resource and sets the Terraform state.
+func (r *ForumPostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = tflog.SetField(ctx, "operation", "import")
+
+	idParts := strings.Split(req.ID, "/")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <guild_id>/<id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	// Set the state
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("guild_id"), types.StringValue(idParts[0]))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(idParts[1]))...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ForumPostResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = tflog.SetField(ctx, "operation", "read")
+
+	var state, provided ForumPostResourceModel
+
+	// Read the configuration data into the provided struct.
+	diags := req.State.Get(ctx, &provided)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	required := map[string]attr.Value{
+		"guild_id": provided.GuildID,
+		"id":       provided.ID,
+	}
+
+	// Check for null/unknown values
+	common.CheckNonNull(required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check for required values
+	common.CheckRequired(ctx, required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := provided.ID.ValueString()
+	guild_id := provided.GuildID.ValueString()
+
+	// Fetch data from the Discord client
+	result, err := channel.FetchByID(ctx, r.client, guild_id, id)
+	if err != nil {
+		if discord.NotFoundError(err) {
+			// If the resource is not found, force a recreation and return early
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to get %s", resourceMetadataName),
+			err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	forum, err := channel.FetchByID(ctx, r.client, guild_id, result.ParentID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to get %s forum channel", resourceMetadataName),
+			err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The starter message may have been deleted by a moderator, in which case the content is kept as is.
+	message, err := channel.FetchMessageByID(ctx, r.client, id, id)
+	if err != nil && !discord.NotFoundError(err) {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to get %s starter message", resourceMetadataName),
+			err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if message == nil {
+		state.Content = provided.Content
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Reading provided %s %s: %v", resourceMetadataName, resourceMetadataType, provided))
+	tflog.Info(ctx, fmt.Sprintf("Reading state %s %s: %v", resourceMetadataName, resourceMetadataType, state))
+
+	if diags := UpdateModel(result, forum, message, &state, &provided); diags != nil {
+		resp.Diagnostics.Append(diags...)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Updated state %s %s: %v", resourceMetadataName, resourceMetadataType, state))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Revert last_updated to the plan value
+	if !provided.LastUpdated.IsNull() {
+		state.LastUpdated = provided.LastUpdated
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ForumPostResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Get the client from the provider data.
+	client, ok := req.ProviderData.(*discordgo.Session)
+	if !ok {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unexpected %s Configure Type", resourceMetadataType),
+			fmt.Sprintf("Expected *discordgo.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
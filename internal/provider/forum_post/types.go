@@ -0,0 +1,42 @@
+package forum_post
+
+import (
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ForumPostResource defines the resource implementation.
+type ForumPostResource struct {
+	client *discordgo.Session
+}
+
+// ForumPostResourceModel maps the resource schema data.
+type ForumPostResourceModel struct {
+	// LastUpdated is the last time the resource was updated.
+	LastUpdated types.String `tfsdk:"last_updated"`
+
+	// GuildID is the ID of the guild.
+	GuildID types.String `tfsdk:"guild_id"`
+
+	// The ID of the forum channel the post is created in.
+	ChannelID types.String `tfsdk:"channel_id"`
+
+	// The ID of the post (thread). This is also the ID of the starter message.
+	ID types.String `tfsdk:"id"`
+
+	// The title of the post.
+	Name types.String `tfsdk:"name"`
+
+	// The content of the starter message.
+	Content types.String `tfsdk:"content"`
+
+	// The embeds of the starter message.
+	Embeds []common.Embed `tfsdk:"embeds"`
+
+	// The names of the forum tags applied to the post.
+	AppliedTags types.List `tfsdk:"applied_tags"`
+
+	// The IDs of the forum tags applied to the post.
+	AppliedTagIDs types.List `tfsdk:"applied_tag_ids"`
+}
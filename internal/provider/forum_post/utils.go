@@ -0,0 +1,142 @@
+package forum_post
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// resolveTagIDs maps the provided tag names to the IDs of the forum's available tags.
+func resolveTagIDs(forum *discordgo.Channel, names []string) ([]string, error) {
+	ids := make([]string, 0, len(names))
+
+	for _, name := range names {
+		idx := slices.IndexFunc(forum.AvailableTags, func(t discordgo.ForumTag) bool {
+			return t.Name == name
+		})
+
+		if idx == -1 {
+			return nil, fmt.Errorf("forum tag not found in channel %s: name=%s", forum.ID, name)
+		}
+
+		ids = append(ids, forum.AvailableTags[idx].ID)
+	}
+
+	return ids, nil
+}
+
+// resolveTagNames maps the provided tag IDs to the names of the forum's available tags.
+// Tags that no longer exist on the forum are skipped.
+func resolveTagNames(forum *discordgo.Channel, ids []string) []string {
+	names := make([]string, 0, len(ids))
+
+	for _, id := range ids {
+		idx := slices.IndexFunc(forum.AvailableTags, func(t discordgo.ForumTag) bool {
+			return t.ID == id
+		})
+
+		if idx != -1 {
+			names = append(names, forum.AvailableTags[idx].Name)
+		}
+	}
+
+	return names
+}
+
+// messageContent returns the content to send for the starter message.
+func messageContent(model *ForumPostResourceModel) *discordgo.MessageSend {
+	return &discordgo.MessageSend{
+		Content: model.Content.ValueString(),
+		Embeds:  common.ToMessageEmbeds(model.Embeds),
+	}
+}
+
+// messageEdit returns the parameters to edit the starter message in place.
+func messageEdit(model *ForumPostResourceModel) *discordgo.MessageEdit {
+	content := model.Content.ValueString()
+	embeds := common.ToMessageEmbeds(model.Embeds)
+
+	return &discordgo.MessageEdit{
+		Content: &content,
+		Embeds:  &embeds,
+	}
+}
+
+// UpdateModel updates the forum post resource model with the provided thread and starter message.
+func UpdateModel(thread, forum *discordgo.Channel, message *discordgo.Message, model, state *ForumPostResourceModel) diag.Diagnostics {
+	tagIDs, diags := common.ToListType[string, basetypes.StringType](thread.AppliedTags)
+	if diags.HasError() {
+		return diags
+	}
+
+	tagNames, diags := common.ToListType[string, basetypes.StringType](resolveTagNames(forum, thread.AppliedTags))
+	if diags.HasError() {
+		return diags
+	}
+
+	if model == nil {
+		model = &ForumPostResourceModel{}
+	}
+
+	model.ID = types.StringValue(thread.ID)
+	model.GuildID = types.StringValue(thread.GuildID)
+	model.ChannelID = types.StringValue(thread.ParentID)
+	model.Name = types.StringValue(thread.Name)
+	model.AppliedTagIDs = tagIDs
+
+	// Keep a null content when the starter message has no content.
+	if message != nil && (message.Content != "" || !model.Content.IsNull()) {
+		model.Content = types.StringValue(message.Content)
+	}
+
+	if state == nil {
+		// If the plan is nil, return early.
+		return nil
+	}
+
+	// Otherwise, update the model with additional data from the plan.
+
+	// Embeds are owned by the configuration, as Discord adds computed data to them.
+	model.Embeds = state.Embeds
+
+	if model.Content.IsNull() && !state.Content.IsNull() && state.Content.ValueString() == "" {
+		model.Content = state.Content
+	}
+
+	if state.AppliedTags.IsNull() {
+		if len(thread.AppliedTags) > 0 {
+			model.AppliedTags = tagNames
+		} else {
+			model.AppliedTags = state.AppliedTags
+		}
+
+		return nil
+	}
+
+	// Revert the applied tags to the plan value if they contain the same tags.
+	// This keeps the tags in the same order as the plan.
+	planned := make([]string, 0, len(state.AppliedTags.Elements()))
+	for _, v := range state.AppliedTags.Elements() {
+		if s, ok := v.(types.String); ok {
+			planned = append(planned, s.ValueString())
+		}
+	}
+
+	actual := resolveTagNames(forum, thread.AppliedTags)
+
+	slices.Sort(planned)
+	slices.Sort(actual)
+
+	if slices.Equal(planned, actual) {
+		model.AppliedTags = state.AppliedTags
+	} else {
+		model.AppliedTags = tagNames
+	}
+
+	return nil
+}
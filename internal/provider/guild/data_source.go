@@ -230,7 +230,7 @@ func (d *GuildDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	if id != "" {
 		result, err = guild.FetchByID(ctx, d.client, id)
 	} else if name != "" {
-		result, err = guild.FetchByName(ctx, d.client, name)
+		result, err = FetchByName(ctx, d.client, name)
 	} else {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Invalid %s Configuration", datasourceMetadataType),
@@ -0,0 +1,35 @@
+package guild
+
+import (
+	"context"
+
+	"github.com/JustARecord/go-discordutils/base/guild"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
+	"github.com/bwmarrin/discordgo"
+)
+
+// FetchByName fetches the guild with the provided name among the guilds of the bot.
+// An error listing the candidate IDs is returned when several guilds share the name.
+func FetchByName(ctx context.Context, client *discordgo.Session, name string) (*discordgo.Guild, error) {
+	guilds, err := guild.FetchAll(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := []*discordgo.UserGuild{}
+
+	for _, g := range guilds {
+		if g.Name == name {
+			matches = append(matches, g)
+		}
+	}
+
+	match, err := common.SelectUnique("guild", name, matches, func(g *discordgo.UserGuild) string {
+		return g.ID
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return guild.FetchByID(ctx, client, match.ID)
+}
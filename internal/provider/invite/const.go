@@ -0,0 +1,19 @@
+package invite
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+const (
+	datasourceMetadataName = "invite"
+	datasourceMetadataType = "data source"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &InviteDataSource{}
+	_ datasource.DataSourceWithConfigure = &InviteDataSource{}
+)
+
+// URLPrefix is the prefix of invite URLs.
+const URLPrefix = "https://discord.gg/"
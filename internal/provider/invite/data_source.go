@@ -0,0 +1,156 @@
+package invite
+
+import (
+	"context"
+	"fmt"
+
+	discord "github.com/JustARecord/go-discordutils/utils"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// NewInviteDataSource is a helper function to simplify the provider implementation.
+func NewInviteDataSource() datasource.DataSource {
+	return &InviteDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *InviteDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + datasourceMetadataName
+}
+
+// Schema defines the schema for the data source.
+func (d *InviteDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"code": schema.StringAttribute{
+				Description: "The invite code, or a full invite URL.",
+				Required:    true,
+			},
+			"url": schema.StringAttribute{
+				Description: "The full URL of the invite.",
+				Computed:    true,
+			},
+			"guild_id": schema.StringAttribute{
+				Description: "The ID of the guild the invite is for.",
+				Computed:    true,
+			},
+			"guild_name": schema.StringAttribute{
+				Description: "The name of the guild the invite is for.",
+				Computed:    true,
+			},
+			"channel_id": schema.StringAttribute{
+				Description: "The ID of the channel the invite is for.",
+				Computed:    true,
+			},
+			"channel_name": schema.StringAttribute{
+				Description: "The name of the channel the invite is for.",
+				Computed:    true,
+			},
+			"inviter_id": schema.StringAttribute{
+				Description: "The ID of the user who created the invite.",
+				Computed:    true,
+			},
+			"target_type": schema.StringAttribute{
+				Description: "The type of target for a voice channel invite, either 'stream' or 'embedded_application'.",
+				Computed:    true,
+			},
+			"approximate_presence_count": schema.Int32Attribute{
+				Description: "The approximate count of online members of the guild.",
+				Computed:    true,
+			},
+			"approximate_member_count": schema.Int32Attribute{
+				Description: "The approximate count of total members of the guild.",
+				Computed:    true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "The expiration date of the invite, empty if the invite never expires.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *InviteDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = tflog.SetField(ctx, "operation", "read")
+
+	var state InviteDataSourceModel
+
+	// Read the configuration data into the state struct.
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	required := map[string]attr.Value{
+		"code": state.Code,
+	}
+
+	// Check for null/unknown values
+	common.CheckNonNull(required, resp.Diagnostics, datasourceMetadataName, datasourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check for required values
+	common.CheckRequired(ctx, required, resp.Diagnostics, datasourceMetadataName, datasourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	code := ParseCode(state.Code.ValueString())
+
+	// Fetch data from the Discord client
+	result, err := d.client.InviteComplex(code, "", true, true, discordgo.WithContext(ctx))
+	if err != nil {
+		summary := fmt.Sprintf("Failed to get %s", datasourceMetadataName)
+
+		if discord.NotFoundError(err) {
+			summary = fmt.Sprintf("The %s %q does not resolve", datasourceMetadataName, code)
+		}
+
+		resp.Diagnostics.AddError(summary, err.Error())
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	UpdateModel(result, &state)
+
+	tflog.Info(ctx, fmt.Sprintf("Updated state %s %s: %v", datasourceMetadataName, datasourceMetadataType, state))
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *InviteDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Get the client from the provider data.
+	client, ok := req.ProviderData.(*discordgo.Session)
+	if !ok {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unexpected %s Configure Type", datasourceMetadataType),
+			fmt.Sprintf("Expected *discordgo.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
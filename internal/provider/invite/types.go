@@ -0,0 +1,47 @@
+package invite
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// InviteDataSource defines the data source implementation.
+type InviteDataSource struct {
+	client *discordgo.Session
+}
+
+// InviteDataSourceModel maps the data source schema data.
+type InviteDataSourceModel struct {
+	// The invite code, or a full invite URL.
+	Code types.String `tfsdk:"code"`
+
+	// The full URL of the invite.
+	URL types.String `tfsdk:"url"`
+
+	// The ID of the guild the invite is for.
+	GuildID types.String `tfsdk:"guild_id"`
+
+	// The name of the guild the invite is for.
+	GuildName types.String `tfsdk:"guild_name"`
+
+	// The ID of the channel the invite is for.
+	ChannelID types.String `tfsdk:"channel_id"`
+
+	// The name of the channel the invite is for.
+	ChannelName types.String `tfsdk:"channel_name"`
+
+	// The ID of the user who created the invite.
+	InviterID types.String `tfsdk:"inviter_id"`
+
+	// The type of target for a voice channel invite, either 'stream' or 'embedded_application'.
+	TargetType types.String `tfsdk:"target_type"`
+
+	// The approximate count of online members of the guild.
+	ApproximatePresenceCount types.Int32 `tfsdk:"approximate_presence_count"`
+
+	// The approximate count of total members of the guild.
+	ApproximateMemberCount types.Int32 `tfsdk:"approximate_member_count"`
+
+	// The expiration date of the invite, empty if the invite never expires.
+	ExpiresAt types.String `tfsdk:"expires_at"`
+}
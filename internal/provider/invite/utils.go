@@ -0,0 +1,63 @@
+package invite
+
+import (
+	"strings"
+
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TargetTypes maps the invite target types to their names in the schema data.
+var TargetTypes = map[discordgo.InviteTargetType]string{
+	discordgo.InviteTargetStream:              "stream",
+	discordgo.InviteTargetEmbeddedApplication: "embedded_application",
+}
+
+// ParseTargetType returns the invite target type with the provided name.
+func ParseTargetType(name string) (discordgo.InviteTargetType, bool) {
+	for k, v := range TargetTypes {
+		if v == name {
+			return k, true
+		}
+	}
+
+	return 0, false
+}
+
+// ParseCode returns the invite code of the provided code or invite URL.
+func ParseCode(code string) string {
+	code = strings.TrimPrefix(code, URLPrefix)
+	code = strings.TrimPrefix(code, "https://discord.com/invite/")
+
+	return code
+}
+
+// UpdateModel updates the data source model with the provided invite.
+// The configured code is left as is, as it may be a full invite URL.
+func UpdateModel(invite *discordgo.Invite, model *InviteDataSourceModel) {
+	model.URL = types.StringValue(URLPrefix + invite.Code)
+	model.GuildID = types.StringValue("")
+	model.GuildName = types.StringValue("")
+	model.ChannelID = types.StringValue("")
+	model.ChannelName = types.StringValue("")
+	model.InviterID = types.StringValue("")
+	model.TargetType = types.StringValue(TargetTypes[invite.TargetType])
+	model.ApproximatePresenceCount = types.Int32Value(int32(invite.ApproximatePresenceCount))
+	model.ApproximateMemberCount = types.Int32Value(int32(invite.ApproximateMemberCount))
+	model.ExpiresAt = types.StringValue(common.StrDiscordTime(invite.ExpiresAt, "ISO8601"))
+
+	if invite.Guild != nil {
+		model.GuildID = types.StringValue(invite.Guild.ID)
+		model.GuildName = types.StringValue(invite.Guild.Name)
+	}
+
+	if invite.Channel != nil {
+		model.ChannelID = types.StringValue(invite.Channel.ID)
+		model.ChannelName = types.StringValue(invite.Channel.Name)
+	}
+
+	if invite.Inviter != nil {
+		model.InviterID = types.StringValue(invite.Inviter.ID)
+	}
+}
@@ -45,6 +45,10 @@ func (d *MemberDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Optional:    true,
 				Computed:    true,
 			},
+			"match_index": schema.Int32Attribute{
+				Description: common.MatchIndexDescription("member"),
+				Optional:    true,
+			},
 			"discriminator": schema.StringAttribute{
 				Description: "The user's Discord-tag.",
 				Optional:    true,
@@ -110,7 +114,7 @@ func (d *MemberDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 func (d *MemberDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	ctx = tflog.SetField(ctx, "operation", "read")
 
-	var state, provided MemberDataSourceModel
+	var state, provided MemberLookupModel
 
 	// Read the configuration data into the provided struct.
 	diags := req.Config.Get(ctx, &provided)
@@ -148,7 +152,12 @@ func (d *MemberDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	if id != "" {
 		result, err = member.FetchById(ctx, d.client, guild_id, id)
 	} else if username != "" {
-		result, err = member.FetchByName(ctx, d.client, guild_id, username)
+		var members []*discordgo.Member
+
+		members, err = FetchAllByName(ctx, d.client, guild_id, username)
+		if err == nil {
+			result, err = common.SelectMatch("member", username, members, memberID, provided.MatchIndex)
+		}
 	} else {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Invalid %s Configuration", datasourceMetadataType),
@@ -199,7 +208,7 @@ func (d *MemberDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	// Map the result data to the state.
-	state = MemberDataSourceModel{
+	state.MemberDataSourceModel = MemberDataSourceModel{
 		GuildID:                    types.StringValue(guild_id),
 		ID:                         types.StringValue(result.User.ID),
 		Username:                   types.StringValue(result.User.Username),
@@ -217,6 +226,7 @@ func (d *MemberDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		Permissions:                permissionsList,
 		CommunicationDisabledUntil: types.StringValue(common.StrDiscordTime(result.CommunicationDisabledUntil, "ISO8601")),
 	}
+	state.MatchIndex = provided.MatchIndex
 
 	// Set state
 	diags = resp.State.Set(ctx, &state)
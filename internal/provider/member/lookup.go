@@ -0,0 +1,65 @@
+package member
+
+import (
+	"context"
+
+	"github.com/JustARecord/go-discordutils/base/member"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
+	"github.com/bwmarrin/discordgo"
+)
+
+// memberID returns the user ID of the member, used to order the members matching a username.
+func memberID(m *discordgo.Member) string {
+	return m.User.ID
+}
+
+// filterByName returns the members with the provided username.
+func filterByName(members []*discordgo.Member, username string) []*discordgo.Member {
+	result := []*discordgo.Member{}
+
+	for _, m := range members {
+		if m.User != nil && m.User.Username == username {
+			result = append(result, m)
+		}
+	}
+
+	return result
+}
+
+// FetchAllByName fetches all the members with the provided username.
+// Usernames are not unique for users that have not migrated from the legacy discriminator system.
+func FetchAllByName(ctx context.Context, client *discordgo.Session, guildID, username string) ([]*discordgo.Member, error) {
+	members, err := member.All(ctx, client, guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	return filterByName(members, username), nil
+}
+
+// FetchByNames fetches the members with the provided usernames, fetching the members of the guild once.
+// Usernames without a member are skipped, and an error listing the candidate IDs is returned when a username matches several members.
+func FetchByNames(ctx context.Context, client *discordgo.Session, guildID string, usernames []string) ([]*discordgo.Member, error) {
+	members, err := member.All(ctx, client, guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*discordgo.Member, 0, len(usernames))
+
+	for _, username := range usernames {
+		matches := filterByName(members, username)
+		if len(matches) == 0 {
+			continue
+		}
+
+		m, err := common.SelectUnique("member", username, matches, memberID)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, m)
+	}
+
+	return result, nil
+}
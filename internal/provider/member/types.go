@@ -10,6 +10,14 @@ type MemberDataSource struct {
 	client *discordgo.Session
 }
 
+// MemberLookupModel maps the data source schema data, including the attributes used only to look up the member.
+type MemberLookupModel struct {
+	// The index of the member to select when several members share the username.
+	MatchIndex types.Int32 `tfsdk:"match_index"`
+
+	MemberDataSourceModel
+}
+
 // MemberDataSourceModel maps the data source schema data.
 type MemberDataSourceModel struct {
 	// GuildID is the ID of the guild.
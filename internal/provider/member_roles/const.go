@@ -0,0 +1,19 @@
+package member_roles
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+const (
+	resourceMetadataName = "member_roles"
+	resourceMetadataType = "resource"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &MemberRolesResource{}
+	_ resource.ResourceWithConfigure      = &MemberRolesResource{}
+	_ resource.ResourceWithImportState    = &MemberRolesResource{}
+	_ resource.ResourceWithValidateConfig = &MemberRolesResource{}
+	_ resource.ResourceWithModifyPlan     = &MemberRolesResource{}
+)
@@ -0,0 +1,494 @@
+package member_roles
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/JustARecord/go-discordutils/base/role"
+	discord "github.com/JustARecord/go-discordutils/utils"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
+	tfrole "github.com/TheCodedCloud/terraform-provider-discord/internal/provider/role"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// NewMemberRolesResource is a helper function to simplify the provider implementation.
+func NewMemberRolesResource() resource.Resource {
+	return &MemberRolesResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *MemberRolesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + resourceMetadataName
+}
+
+// Schema defines the schema for the resource.
+func (r *MemberRolesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the roles of a single guild member, setting all of them in one request. " +
+			"Do not use together with discord_role_members for the same roles in authoritative mode.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the resource. This is the ID of the user.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"guild_id": schema.StringAttribute{
+				Description: "The ID of the guild. Changing this recreates the resource.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "The ID of the user. Changing this recreates the resource.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role_ids": schema.SetAttribute{
+				Description: "The IDs of the roles of the member. Roles managed by an integration, such as bot and booster roles, cannot be assigned or removed.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"mode": schema.StringAttribute{
+				Description: fmt.Sprintf("Either '%s', which makes the member hold only the roles in role_ids and removes any other role on apply, or '%s', "+
+					"which only adds the roles in role_ids, and takes away the roles it granted when they are removed from role_ids or the resource is destroyed. "+
+					"Roles managed by an integration are never removed. Defaults to '%s'.", common.ModeAuthoritative, common.ModeAdditive, common.ModeAuthoritative),
+				Optional: true,
+			},
+			"added_role_ids": schema.SetAttribute{
+				Description: "The IDs of the roles granted by this resource, which are taken away on destroy. " +
+					"In additive mode, roles the member already held are not included.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "The last time the resource was updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the resource configuration.
+func (r *MemberRolesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config MemberRolesResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(common.ValidateMode(config.Mode, resourceMetadataType)...)
+
+	if config.GuildID.IsUnknown() || config.RoleIDs.IsUnknown() {
+		return
+	}
+
+	roleIDs, diags := fromSet(ctx, config.RoleIDs)
+	resp.Diagnostics.Append(diags...)
+
+	// The @everyone role has the ID of the guild, and is held by every member
+	if slices.Contains(roleIDs, config.GuildID.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("role_ids"),
+			fmt.Sprintf("Invalid %s Configuration", resourceMetadataType),
+			"The @everyone role cannot be assigned, as every member holds it.",
+		)
+	}
+}
+
+// ModifyPlan checks that the roles added to or removed from the member are below the highest role of the bot,
+// as Discord rejects assigning them otherwise.
+func (r *MemberRolesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || !common.PlanChanged(req.Plan.Raw, req.State.Raw, "last_updated") {
+		return
+	}
+
+	var plan, state MemberRolesResourceModel
+
+	if !req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	}
+
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model := &plan
+	if req.Plan.Raw.IsNull() {
+		model = &state
+	}
+
+	if model.GuildID.IsUnknown() || model.UserID.IsUnknown() || plan.RoleIDs.IsUnknown() {
+		return
+	}
+
+	guildID := model.GuildID.ValueString()
+
+	member, managed, err := r.fetch(ctx, guildID, model.UserID.ValueString())
+	if err != nil {
+		// A missing member is reported on apply
+		if !discord.NotFoundError(err) {
+			resp.Diagnostics.AddError("Failed to check the role hierarchy", err.Error())
+		}
+
+		return
+	}
+
+	desired, diags := fromSet(ctx, plan.RoleIDs)
+	resp.Diagnostics.Append(diags...)
+
+	added, diags := addedRoleIDs(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Destroying the resource takes the granted roles away, as in additive mode
+	authoritative := isAuthoritative(model) && !req.Plan.Raw.IsNull()
+
+	changed := changedRoles(member.Roles, targetRoles(member.Roles, managed, desired, added, authoritative))
+	if len(changed) == 0 {
+		return
+	}
+
+	resp.Diagnostics.Append(tfrole.CheckBotHierarchy(ctx, r.client, guildID, changed)...)
+}
+
+// fetch returns the member, and the IDs of the roles of the guild that are managed by an integration.
+func (r *MemberRolesResource) fetch(ctx context.Context, guildID, userID string) (*discordgo.Member, []string, error) {
+	member, err := r.client.GuildMember(guildID, userID, discordgo.WithContext(ctx))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	roles, err := role.AllByID(ctx, r.client, guildID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return member, managedRoleIDs(roles), nil
+}
+
+// apply sets the roles of the member to match the plan in a single request, then updates the plan.
+// The added role IDs are the roles granted by the resource, which are taken away in additive mode when they are removed from the plan.
+func (r *MemberRolesResource) apply(ctx context.Context, plan *MemberRolesResourceModel, added []string) diag.Diagnostics {
+	desired, diags := fromSet(ctx, plan.RoleIDs)
+	if diags.HasError() {
+		return diags
+	}
+
+	guildID := plan.GuildID.ValueString()
+
+	member, managed, err := r.fetch(ctx, guildID, plan.UserID.ValueString())
+	if err != nil {
+		return errorDiagnostics("Failed to get member", err)
+	}
+
+	target := targetRoles(member.Roles, managed, desired, added, isAuthoritative(plan))
+	granted := grantedRoles(member.Roles, desired, added, isAuthoritative(plan))
+
+	result, err := r.client.GuildMemberEdit(guildID, member.User.ID, &discordgo.GuildMemberParams{
+		Roles: &target,
+	}, discordgo.WithContext(ctx))
+	if err != nil {
+		return errorDiagnostics("Failed to set roles", err)
+	}
+
+	return UpdateModel(ctx, guildID, result, managed, granted, plan)
+}
+
+// errorDiagnostics returns the error as diagnostics for the resource.
+func errorDiagnostics(summary string, err error) diag.Diagnostics {
+	return diag.Diagnostics{
+		diag.NewErrorDiagnostic(fmt.Sprintf("%s for %s", summary, resourceMetadataName), err.Error()),
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *MemberRolesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = tflog.SetField(ctx, "operation", "create")
+
+	var plan MemberRolesResourceModel
+
+	// Retrieve values from plan
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	required := map[string]attr.Value{
+		"guild_id": plan.GuildID,
+		"user_id":  plan.UserID,
+		"role_ids": plan.RoleIDs,
+	}
+
+	// Check for null/unknown values
+	common.CheckNonNull(required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Reading plan %s %s: %v", resourceMetadataName, resourceMetadataType, plan))
+
+	// Create the resource
+	resp.Diagnostics.Append(r.apply(ctx, &plan, []string{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the LastUpdated field to the current time.
+	plan.LastUpdated = types.StringValue(common.CurrentTime())
+
+	tflog.Info(ctx, fmt.Sprintf("Updated plan %s %s: %v", resourceMetadataName, resourceMetadataType, plan))
+
+	// Set the state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *MemberRolesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = tflog.SetField(ctx, "operation", "update")
+
+	var plan, state MemberRolesResourceModel
+
+	// Retrieve values from plan and state
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	required := map[string]attr.Value{
+		"guild_id": plan.GuildID,
+		"user_id":  plan.UserID,
+		"role_ids": plan.RoleIDs,
+	}
+
+	// Check for null/unknown values
+	common.CheckNonNull(required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	added, diags := addedRoleIDs(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Reading plan %s %s: %v", resourceMetadataName, resourceMetadataType, plan))
+
+	// Update the resource
+	resp.Diagnostics.Append(r.apply(ctx, &plan, added)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the LastUpdated field to the current time.
+	plan.LastUpdated = types.StringValue(common.CurrentTime())
+
+	tflog.Info(ctx, fmt.Sprintf("Updated plan %s %s: %v", resourceMetadataName, resourceMetadataType, plan))
+
+	// Set the state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete takes the roles granted by the resource away from the member and removes the Terraform state on success.
+func (r *MemberRolesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = tflog.SetField(ctx, "operation", "delete")
+
+	var state MemberRolesResourceModel
+
+	// Retrieve values from state
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	added, diags := addedRoleIDs(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	guildID := state.GuildID.ValueString()
+
+	member, managed, err := r.fetch(ctx, guildID, state.UserID.ValueString())
+	if err != nil {
+		// The member left the guild, so the roles are gone too
+		if discord.NotFoundError(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to delete %s", resourceMetadataName),
+			err.Error(),
+		)
+
+		return
+	}
+
+	target := targetRoles(member.Roles, managed, []string{}, added, false)
+
+	_, err = r.client.GuildMemberEdit(guildID, member.User.ID, &discordgo.GuildMemberParams{
+		Roles: &target,
+	}, discordgo.WithContext(ctx))
+	if err != nil && !discord.NotFoundError(err) {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to delete %s", resourceMetadataName),
+			err.Error(),
+		)
+	}
+}
+
+// Import imports the resource and sets the Terraform state.
+func (r *MemberRolesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = tflog.SetField(ctx, "operation", "import")
+
+	idParts := strings.Split(req.ID, "/")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <guild_id>/<user_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	// Set the state
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("guild_id"), types.StringValue(idParts[0]))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), types.StringValue(idParts[1]))...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *MemberRolesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = tflog.SetField(ctx, "operation", "read")
+
+	var provided MemberRolesResourceModel
+
+	// Read the configuration data into the provided struct.
+	diags := req.State.Get(ctx, &provided)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	required := map[string]attr.Value{
+		"guild_id": provided.GuildID,
+		"user_id":  provided.UserID,
+	}
+
+	// Check for null/unknown values
+	common.CheckNonNull(required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check for required values
+	common.CheckRequired(ctx, required, resp.Diagnostics, resourceMetadataName, resourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	guildID := provided.GuildID.ValueString()
+
+	// Fetch data from the Discord client
+	member, managed, err := r.fetch(ctx, guildID, provided.UserID.ValueString())
+	if err != nil {
+		if discord.NotFoundError(err) {
+			// The member left the guild, so the roles are gone too
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to get %s", resourceMetadataName),
+			err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Reading provided %s %s: %v", resourceMetadataName, resourceMetadataType, provided))
+
+	added, diags := addedRoleIDs(ctx, &provided)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The model keeps the configured mode and role IDs, which decide which roles are reported
+	state := provided
+
+	resp.Diagnostics.Append(UpdateModel(ctx, guildID, member, managed, added, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Updated state %s %s: %v", resourceMetadataName, resourceMetadataType, state))
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *MemberRolesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Get the client from the provider data.
+	client, ok := req.ProviderData.(*discordgo.Session)
+	if !ok {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unexpected %s Configure Type", resourceMetadataType),
+			fmt.Sprintf("Expected *discordgo.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
@@ -0,0 +1,35 @@
+package member_roles
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// MemberRolesResource defines the resource implementation.
+type MemberRolesResource struct {
+	client *discordgo.Session
+}
+
+// MemberRolesResourceModel maps the resource schema data.
+type MemberRolesResourceModel struct {
+	// LastUpdated is the last time the resource was updated.
+	LastUpdated types.String `tfsdk:"last_updated"`
+
+	// The ID of the resource. This is the ID of the user.
+	ID types.String `tfsdk:"id"`
+
+	// The ID of the guild.
+	GuildID types.String `tfsdk:"guild_id"`
+
+	// The ID of the user.
+	UserID types.String `tfsdk:"user_id"`
+
+	// The IDs of the roles of the member.
+	RoleIDs types.Set `tfsdk:"role_ids"`
+
+	// Either "authoritative" or "additive". Defaults to "authoritative".
+	Mode types.String `tfsdk:"mode"`
+
+	// The IDs of the roles granted by the resource, which are taken away on destroy.
+	AddedRoleIDs types.Set `tfsdk:"added_role_ids"`
+}
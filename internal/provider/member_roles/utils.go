@@ -0,0 +1,165 @@
+package member_roles
+
+import (
+	"context"
+	"slices"
+
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// fromSet converts the role IDs of the model to a list of strings.
+func fromSet(ctx context.Context, set types.Set) ([]string, diag.Diagnostics) {
+	if set.IsNull() || set.IsUnknown() {
+		return []string{}, nil
+	}
+
+	elements := make([]types.String, 0, len(set.Elements()))
+
+	diags := set.ElementsAs(ctx, &elements, false)
+
+	return common.FromStringList(elements), diags
+}
+
+// isAuthoritative returns whether the roles of the member not in role_ids are managed, which is the default.
+func isAuthoritative(model *MemberRolesResourceModel) bool {
+	return !common.IsAdditive(model.Mode)
+}
+
+// addedRoleIDs returns the IDs of the roles granted by the resource.
+// States written before the granted roles were tracked fall back to the role IDs.
+func addedRoleIDs(ctx context.Context, model *MemberRolesResourceModel) ([]string, diag.Diagnostics) {
+	if model.AddedRoleIDs.IsNull() {
+		return fromSet(ctx, model.RoleIDs)
+	}
+
+	return fromSet(ctx, model.AddedRoleIDs)
+}
+
+// grantedRoles returns the desired roles that are granted by the resource, either because the member does not hold
+// them yet, or because the resource granted them before. Roles the member already held are not granted in additive mode.
+func grantedRoles(current, desired, added []string, authoritative bool) []string {
+	if authoritative {
+		return desired
+	}
+
+	result := []string{}
+
+	for _, id := range desired {
+		if !slices.Contains(current, id) || slices.Contains(added, id) {
+			result = append(result, id)
+		}
+	}
+
+	return result
+}
+
+// managedRoleIDs returns the IDs of the roles managed by an integration, which cannot be assigned or removed.
+func managedRoleIDs(roles []*discordgo.Role) []string {
+	result := []string{}
+
+	for _, r := range roles {
+		if r.Managed {
+			result = append(result, r.ID)
+		}
+	}
+
+	return result
+}
+
+// targetRoles returns the roles the member should hold after the apply.
+// In authoritative mode, the member holds the desired roles, and keeps the managed roles it already holds.
+// In additive mode, the roles granted by the resource that are no longer desired are taken away, and the desired roles are added.
+func targetRoles(current, managed, desired, added []string, authoritative bool) []string {
+	result := []string{}
+
+	for _, id := range current {
+		keep := !slices.Contains(added, id) || slices.Contains(desired, id)
+		if authoritative {
+			keep = slices.Contains(managed, id)
+		}
+
+		if keep && !slices.Contains(result, id) {
+			result = append(result, id)
+		}
+	}
+
+	for _, id := range desired {
+		if !slices.Contains(result, id) {
+			result = append(result, id)
+		}
+	}
+
+	return result
+}
+
+// changedRoles returns the roles that are added or removed when moving from the current to the target roles.
+func changedRoles(current, target []string) []string {
+	result := []string{}
+
+	for _, id := range current {
+		if !slices.Contains(target, id) {
+			result = append(result, id)
+		}
+	}
+
+	for _, id := range target {
+		if !slices.Contains(current, id) {
+			result = append(result, id)
+		}
+	}
+
+	return result
+}
+
+// UpdateModel updates the member roles resource model with the roles of the member.
+// In authoritative mode, all the roles of the member are reported, except unconfigured managed roles, and are all granted by the resource.
+// In additive mode, only the previously configured roles that the member still holds are reported, and only the provided
+// added roles that the member still holds are granted by the resource.
+func UpdateModel(ctx context.Context, guildID string, member *discordgo.Member, managed, added []string, model *MemberRolesResourceModel) diag.Diagnostics {
+	previous, diags := fromSet(ctx, model.RoleIDs)
+	if diags.HasError() {
+		return diags
+	}
+
+	ids := []string{}
+
+	for _, id := range member.Roles {
+		if isAuthoritative(model) && (!slices.Contains(managed, id) || slices.Contains(previous, id)) {
+			ids = append(ids, id)
+		} else if !isAuthoritative(model) && slices.Contains(previous, id) {
+			ids = append(ids, id)
+		}
+	}
+
+	roleIDs, diags := types.SetValueFrom(ctx, types.StringType, ids)
+	if diags.HasError() {
+		return diags
+	}
+
+	granted := ids
+	if !isAuthoritative(model) {
+		granted = []string{}
+
+		for _, id := range added {
+			if slices.Contains(member.Roles, id) {
+				granted = append(granted, id)
+			}
+		}
+	}
+
+	addedRoleIDs, diags := types.SetValueFrom(ctx, types.StringType, granted)
+	if diags.HasError() {
+		return diags
+	}
+
+	model.ID = types.StringValue(member.User.ID)
+	model.GuildID = types.StringValue(guildID)
+	model.UserID = types.StringValue(member.User.ID)
+	model.RoleIDs = roleIDs
+	model.AddedRoleIDs = addedRoleIDs
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package message
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+const (
+	resourceMetadataName = "message"
+	resourceMetadataType = "resource"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &MessageResource{}
+	_ resource.ResourceWithConfigure   = &MessageResource{}
+	_ resource.ResourceWithImportState = &MessageResource{}
+)
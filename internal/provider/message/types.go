@@ -0,0 +1,42 @@
+package message
+
+import (
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// MessageResource defines the resource implementation.
+type MessageResource struct {
+	client *discordgo.Session
+}
+
+// MessageResourceModel maps the resource schema data.
+type MessageResourceModel struct {
+	// LastUpdated is the last time the resource was updated.
+	LastUpdated types.String `tfsdk:"last_updated"`
+
+	// The ID of the message.
+	ID types.String `tfsdk:"id"`
+
+	// The ID of the channel the message is sent in.
+	ChannelID types.String `tfsdk:"channel_id"`
+
+	// The content of the message.
+	Content types.String `tfsdk:"content"`
+
+	// The embeds of the message.
+	Embeds []common.Embed `tfsdk:"embeds"`
+
+	// The mentions that are allowed to ping in the message.
+	AllowedMentions *common.AllowedMentions `tfsdk:"allowed_mentions"`
+
+	// The paths of the local files to attach to the message.
+	Attachments types.List `tfsdk:"attachments"`
+
+	// The message components, as a JSON array.
+	Components types.String `tfsdk:"components"`
+
+	// Whether the message is pinned in the channel.
+	Pinned types.Bool `tfsdk:"pinned"`
+}
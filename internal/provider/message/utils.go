@@ -0,0 +1,123 @@
+package message
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// messageParams holds the parameters that are shared by sending and editing a message.
+type messageParams struct {
+	Content         string
+	Embeds          []*discordgo.MessageEmbed
+	AllowedMentions *discordgo.MessageAllowedMentions
+	Components      []discordgo.MessageComponent
+	Files           []*discordgo.File
+}
+
+// setupParams converts the model into the parameters of the message.
+// The attachments are only read from disk when withFiles is set.
+func setupParams(ctx context.Context, model *MessageResourceModel, withFiles bool) (*messageParams, error) {
+	params := &messageParams{
+		Content: model.Content.ValueString(),
+		Embeds:  common.ToMessageEmbeds(model.Embeds),
+	}
+
+	mentions, err := common.ToAllowedMentions(model.AllowedMentions)
+	if err != nil {
+		return nil, err
+	}
+
+	params.AllowedMentions = mentions
+
+	components, err := common.ToMessageComponents(model.Components.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	params.Components = components
+
+	if withFiles && !model.Attachments.IsNull() {
+		paths, diags := common.FromListType(ctx, model.Attachments)
+		if diags.HasError() {
+			return nil, fmt.Errorf("failed to read attachments")
+		}
+
+		files, err := common.ToMessageFiles(paths)
+		if err != nil {
+			return nil, err
+		}
+
+		params.Files = files
+	}
+
+	return params, nil
+}
+
+// messageSend returns the parameters to send the message.
+func (p *messageParams) messageSend() *discordgo.MessageSend {
+	return &discordgo.MessageSend{
+		Content:         p.Content,
+		Embeds:          p.Embeds,
+		AllowedMentions: p.AllowedMentions,
+		Components:      p.Components,
+		Files:           p.Files,
+	}
+}
+
+// messageEdit returns the parameters to edit the message in place.
+// When replaceFiles is set, the existing attachments are replaced with the files of the parameters.
+func (p *messageParams) messageEdit(replaceFiles bool) *discordgo.MessageEdit {
+	edit := &discordgo.MessageEdit{
+		Content:         &p.Content,
+		Embeds:          &p.Embeds,
+		AllowedMentions: p.AllowedMentions,
+		Components:      &p.Components,
+	}
+
+	if replaceFiles {
+		attachments := []*discordgo.MessageAttachment{}
+
+		edit.Attachments = &attachments
+		edit.Files = p.Files
+	}
+
+	return edit
+}
+
+// UpdateModel updates the message resource model with the provided message.
+func UpdateModel(message *discordgo.Message, model, state *MessageResourceModel) {
+	if model == nil {
+		model = &MessageResourceModel{}
+	}
+
+	model.ID = types.StringValue(message.ID)
+	model.ChannelID = types.StringValue(message.ChannelID)
+	model.Pinned = types.BoolValue(message.Pinned)
+
+	// Keep a null content when the message has no content.
+	if message.Content != "" || !model.Content.IsNull() {
+		model.Content = types.StringValue(message.Content)
+	}
+
+	if state == nil {
+		// If the plan is nil, return early.
+		return
+	}
+
+	// Otherwise, update the model with additional data from the plan.
+
+	// Embeds, mentions, attachments and components are owned by the configuration,
+	// as Discord adds computed data to them or does not return them.
+	model.Embeds = state.Embeds
+	model.AllowedMentions = state.AllowedMentions
+	model.Attachments = state.Attachments
+	model.Components = state.Components
+
+	if model.Content.IsNull() && !state.Content.IsNull() && state.Content.ValueString() == "" {
+		model.Content = state.Content
+	}
+}
@@ -8,12 +8,28 @@ import (
 	"os"
 
 	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/channel"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/channel_follower"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/channel_invite"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/channel_order"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/channel_pins"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/channel_retention"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/channels"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/everyone_role"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/forum_post"
 	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/guild"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/invite"
 	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/member"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/member_roles"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/message"
 	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/permissions"
 	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/role"
 	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/role_members"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/role_order"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/roles"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/stage_instance"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/threads"
 	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/webhook"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/webhook_message"
 	"github.com/bwmarrin/discordgo"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
@@ -162,6 +178,18 @@ func (p *DiscordProvider) Resources(ctx context.Context) []func() resource.Resou
 		permissions.NewPermissionsResource,
 		webhook.NewWebhookResource,
 		role_members.NewRoleMembersResource,
+		forum_post.NewForumPostResource,
+		channel_order.NewChannelOrderResource,
+		channel_invite.NewChannelInviteResource,
+		channel_follower.NewChannelFollowerResource,
+		message.NewMessageResource,
+		webhook_message.NewWebhookMessageResource,
+		stage_instance.NewStageInstanceResource,
+		channel_pins.NewChannelPinsResource,
+		channel_retention.NewChannelRetentionResource,
+		role_order.NewRoleOrderResource,
+		everyone_role.NewEveryoneRoleResource,
+		member_roles.NewMemberRolesResource,
 	}
 }
 
@@ -175,6 +203,10 @@ func (p *DiscordProvider) DataSources(ctx context.Context) []func() datasource.D
 		webhook.NewWebhookDataSource,
 		member.NewMemberDataSource,
 		role_members.NewRoleMembersDataSource,
+		channels.NewChannelsDataSource,
+		invite.NewInviteDataSource,
+		threads.NewThreadsDataSource,
+		roles.NewRolesDataSource,
 	}
 }
 
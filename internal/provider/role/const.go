@@ -23,7 +23,9 @@ const (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &RoleResource{}
-	_ resource.ResourceWithConfigure   = &RoleResource{}
-	_ resource.ResourceWithImportState = &RoleResource{}
+	_ resource.Resource                   = &RoleResource{}
+	_ resource.ResourceWithConfigure      = &RoleResource{}
+	_ resource.ResourceWithImportState    = &RoleResource{}
+	_ resource.ResourceWithValidateConfig = &RoleResource{}
+	_ resource.ResourceWithModifyPlan     = &RoleResource{}
 )
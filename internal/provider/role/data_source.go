@@ -4,15 +4,12 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/JustARecord/go-discordutils/base/role"
-	discord "github.com/JustARecord/go-discordutils/utils"
 	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
 	"github.com/bwmarrin/discordgo"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -44,6 +41,10 @@ func (d *RoleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				Optional:    true,
 				Computed:    true,
 			},
+			"match_index": schema.Int32Attribute{
+				Description: common.MatchIndexDescription("role"),
+				Optional:    true,
+			},
 			"managed": schema.BoolAttribute{
 				Description: "Whether this role is managed by an integration, and thus cannot be manually added to, or taken from, members.",
 				Computed:    true,
@@ -82,6 +83,11 @@ func (d *RoleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				Computed:    true,
 				ElementType: types.StringType,
 			},
+			"tags": schema.SingleNestedAttribute{
+				Description: "The tags of the role, which tell bot, integration, booster and subscription roles apart.",
+				Computed:    true,
+				Attributes:  RoleTagsSchema,
+			},
 		},
 	}
 }
@@ -90,7 +96,7 @@ func (d *RoleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 func (d *RoleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	ctx = tflog.SetField(ctx, "operation", "read")
 
-	var state, provided RoleDataSourceModel
+	var state, provided RoleLookupModel
 
 	// Read the configuration data into the provided struct.
 	diags := req.Config.Get(ctx, &provided)
@@ -122,18 +128,20 @@ func (d *RoleDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	guild_id := provided.GuildID.ValueString()
 
 	var result *discordgo.Role
-	var err error
-
-	// Fetch data from the Discord client
-	if id != "" {
-		result, err = role.FetchByID(ctx, d.client, guild_id, id)
-	} else if name != "" {
-		result, err = role.FetchByName(ctx, d.client, guild_id, name)
-	} else {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Invalid %s Configuration", datasourceMetadataType),
-			fmt.Sprintf("Either the id or the name must be set for the %s %s.", datasourceMetadataName, datasourceMetadataType),
-		)
+
+	// Fetch data from the Discord client, the roles are listed with their tags
+	roles, tags, err := FetchAllWithTags(ctx, d.client, guild_id)
+	if err == nil {
+		if id != "" {
+			result, err = findByID(roles, id)
+		} else if name != "" {
+			result, err = common.SelectMatch("role", name, filterByName(roles, name), roleID, provided.MatchIndex)
+		} else {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Invalid %s Configuration", datasourceMetadataType),
+				fmt.Sprintf("Either the id or the name must be set for the %s %s.", datasourceMetadataName, datasourceMetadataType),
+			)
+		}
 	}
 
 	if err != nil {
@@ -144,34 +152,15 @@ func (d *RoleDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	permissions := discord.ListStringify(result.Permissions)
-	flags := discord.ListStringify(result.Flags)
-
-	permissionsList, diags := common.ToListType[string, basetypes.StringType](permissions)
-	resp.Diagnostics.Append(diags...)
-
-	flagsList, diags := common.ToListType[string, basetypes.StringType](flags)
+	// Map the result data to the state.
+	model, diags := ToDataSourceModel(result, guild_id, tags[result.ID])
 	resp.Diagnostics.Append(diags...)
-
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Map the result data to the state.
-	state = RoleDataSourceModel{
-		GuildID:      types.StringValue(guild_id),
-		ID:           types.StringValue(result.ID),
-		Name:         types.StringValue(result.Name),
-		Managed:      types.BoolValue(result.Managed),
-		Mentionable:  types.BoolValue(result.Mentionable),
-		Hoist:        types.BoolValue(result.Hoist),
-		Color:        types.StringValue(common.StrHex(result.Color)),
-		Position:     types.Int32Value(int32(result.Position)),
-		Permissions:  permissionsList,
-		Icon:         types.StringValue(result.Icon),
-		UnicodeEmoji: types.StringValue(result.UnicodeEmoji),
-		Flags:        flagsList,
-	}
+	state.RoleDataSourceModel = *model
+	state.MatchIndex = provided.MatchIndex
 
 	// Set state
 	diags = resp.State.Set(ctx, &state)
@@ -0,0 +1,124 @@
+package role
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/JustARecord/go-discordutils/base/role"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// compareRoles compares the roles by their place in the role hierarchy, from the bottom to the top.
+// Discord breaks ties between equal positions by ID, the older role is placed higher.
+func compareRoles(a, b *discordgo.Role) int {
+	if a.Position != b.Position {
+		return a.Position - b.Position
+	}
+
+	if len(a.ID) != len(b.ID) {
+		return len(b.ID) - len(a.ID)
+	}
+
+	return strings.Compare(b.ID, a.ID)
+}
+
+// SortRoles returns the roles sorted from the bottom to the top of the role hierarchy.
+func SortRoles(roles []*discordgo.Role) []*discordgo.Role {
+	result := slices.Clone(roles)
+	slices.SortStableFunc(result, compareRoles)
+
+	return result
+}
+
+// FetchBotHighestRole returns the highest role of the bot in the guild.
+// The @everyone role is returned when the bot has no other roles.
+func FetchBotHighestRole(ctx context.Context, client *discordgo.Session, guildID string, roles []*discordgo.Role) (*discordgo.Role, error) {
+	user, err := client.User("@me", discordgo.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := client.GuildMember(guildID, user.ID, discordgo.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	var highest *discordgo.Role
+
+	for _, r := range roles {
+		// The @everyone role has the ID of the guild
+		if r.ID != guildID && !slices.Contains(member.Roles, r.ID) {
+			continue
+		}
+
+		if highest == nil || compareRoles(r, highest) > 0 {
+			highest = r
+		}
+	}
+
+	return highest, nil
+}
+
+// fetchBotHierarchy returns the roles of the guild, and the highest role of the bot.
+func fetchBotHierarchy(ctx context.Context, client *discordgo.Session, guildID string) ([]*discordgo.Role, *discordgo.Role, error) {
+	roles, err := role.AllByID(ctx, client, guildID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	highest, err := FetchBotHighestRole(ctx, client, guildID, roles)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get the highest role of the bot: %w", err)
+	}
+
+	return roles, highest, nil
+}
+
+// checkRoles checks that the roles with the provided IDs are below the highest role of the bot.
+// Unknown role IDs are skipped, as well as the @everyone role, which is always at the bottom of the hierarchy.
+func checkRoles(roles []*discordgo.Role, highest *discordgo.Role, guildID string, roleIDs []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, r := range roles {
+		if r.ID == guildID || !slices.Contains(roleIDs, r.ID) {
+			continue
+		}
+
+		if highest == nil || compareRoles(r, highest) >= 0 {
+			diags.Append(hierarchyError(r, highest))
+		}
+	}
+
+	return diags
+}
+
+// hierarchyError returns the error reported for a role that is not below the highest role of the bot.
+func hierarchyError(blocking, highest *discordgo.Role) diag.Diagnostic {
+	highestName := "none"
+	if highest != nil {
+		highestName = fmt.Sprintf("%q (%s)", highest.Name, highest.ID)
+	}
+
+	return diag.NewErrorDiagnostic(
+		"Role above the bot",
+		fmt.Sprintf("The role %q (%s) is not below the highest role of the bot, %s. "+
+			"Discord rejects editing, deleting and assigning this role with a 50013 Missing Permissions error. "+
+			"Move the role of the bot above it in the role hierarchy.", blocking.Name, blocking.ID, highestName),
+	)
+}
+
+// CheckBotHierarchy checks at plan time that the roles with the provided IDs are below the highest role of the bot,
+// so that the apply does not fail with a 50013 Missing Permissions error when editing or assigning them.
+func CheckBotHierarchy(ctx context.Context, client *discordgo.Session, guildID string, roleIDs []string) diag.Diagnostics {
+	roles, highest, err := fetchBotHierarchy(ctx, client, guildID)
+	if err != nil {
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic("Failed to check the role hierarchy", err.Error()),
+		}
+	}
+
+	return checkRoles(roles, highest, guildID, roleIDs)
+}
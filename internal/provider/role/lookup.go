@@ -0,0 +1,76 @@
+package role
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/JustARecord/go-discordutils/base/role"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
+	"github.com/bwmarrin/discordgo"
+)
+
+// ErrNotFound is returned by the lookups when the role does not exist, such as when it was deleted.
+var ErrNotFound = errors.New("role not found")
+
+// roleID returns the ID of the role, used to order the roles matching a name.
+func roleID(r *discordgo.Role) string {
+	return r.ID
+}
+
+// FetchAllByName fetches all the roles with the provided name, as Discord does not require role names to be unique.
+func FetchAllByName(ctx context.Context, client *discordgo.Session, guildID, name string) ([]*discordgo.Role, error) {
+	return role.FetchByNames(ctx, client, guildID, []string{name})
+}
+
+// FetchByName fetches the role with the provided name.
+// An error listing the candidate IDs is returned when several roles share the name.
+func FetchByName(ctx context.Context, client *discordgo.Session, guildID, name string) (*discordgo.Role, error) {
+	roles, err := FetchAllByName(ctx, client, guildID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(roles) == 0 {
+		return nil, fmt.Errorf("%w: name=%s", ErrNotFound, name)
+	}
+
+	return common.SelectUnique("role", name, roles, roleID)
+}
+
+// FetchByID fetches the role with the provided ID.
+func FetchByID(ctx context.Context, client *discordgo.Session, guildID, id string) (*discordgo.Role, error) {
+	roles, err := role.AllByID(ctx, client, guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	return findByID(roles, id)
+}
+
+// findByID returns the role with the provided ID from the listed roles.
+func findByID(roles []*discordgo.Role, id string) (*discordgo.Role, error) {
+	idx := slices.IndexFunc(roles, func(r *discordgo.Role) bool {
+		return r.ID == id
+	})
+
+	if idx == -1 {
+		return nil, fmt.Errorf("%w: id=%s", ErrNotFound, id)
+	}
+
+	return roles[idx], nil
+}
+
+// filterByName returns the listed roles with the provided name.
+func filterByName(roles []*discordgo.Role, name string) []*discordgo.Role {
+	result := []*discordgo.Role{}
+
+	for _, r := range roles {
+		if r.Name == name {
+			result = append(result, r)
+		}
+	}
+
+	return result
+}
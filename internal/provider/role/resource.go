@@ -3,13 +3,16 @@ package role
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
+	"github.com/JustARecord/go-discordutils/base/guild"
 	"github.com/JustARecord/go-discordutils/base/role"
 	discord "github.com/JustARecord/go-discordutils/utils"
 	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
 	"github.com/bwmarrin/discordgo"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -95,9 +98,11 @@ func (r *RoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"position": schema.Int32Attribute{
-				Description: "The position of this role in the guild's role hierarchy.",
+				Description: "The position of this role in the guild's role hierarchy. Use discord_role_order to manage the order of the roles.",
 				Computed:    true,
 				Optional:    true,
+				DeprecationMessage: "The position is not sent to Discord and a configured value is ignored. " +
+					"Use discord_role_order to manage the order of the roles instead, position becomes read-only in the next release.",
 				PlanModifiers: []planmodifier.Int32{
 					int32planmodifier.UseStateForUnknown(),
 				},
@@ -112,12 +117,31 @@ func (r *RoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"icon": schema.StringAttribute{
-				Description: "The hash of the role icon. Use Role.IconURL to retrieve the icon's URL.",
+				Description: "The hash of the role icon, as returned by Discord. Use icon_file or icon_base64 to upload an icon.",
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"icon_file": schema.StringAttribute{
+				Description: "The path of an image file to upload as the role icon. Changes to the file content are detected. " +
+					"Requires the guild to have the ROLE_ICONS feature. Conflicts with icon_base64.",
+				Optional: true,
+			},
+			"icon_base64": schema.StringAttribute{
+				Description: "The base64 encoded image to upload as the role icon, optionally as a data URI. " +
+					"Requires the guild to have the ROLE_ICONS feature. Conflicts with icon_file.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"if_exists": schema.StringAttribute{
+				Description: common.IfExistsDescription("role"),
+				Optional:    true,
+			},
+			"icon_hash": schema.StringAttribute{
+				Description: "The SHA-256 hash of the uploaded icon image, used to detect changes. Removing icon_file and icon_base64 clears the icon.",
+				Computed:    true,
+			},
 			"unicode_emoji": schema.StringAttribute{
 				Description: "The emoji assigned to this role.",
 				Computed:    true,
@@ -134,10 +158,149 @@ func (r *RoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					listplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"tags": schema.SingleNestedAttribute{
+				Description: "The tags of the role, which tell bot, integration, booster and subscription roles apart.",
+				Computed:    true,
+				Attributes:  RoleTagsResourceSchema,
+			},
 		},
 	}
 }
 
+// ValidateConfig validates the resource configuration.
+func (r *RoleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config RoleResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.IconFile.IsNull() && !config.IconBase64.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("icon_base64"),
+			fmt.Sprintf("Invalid %s Configuration", resourceMetadataType),
+			"icon_file cannot be used together with icon_base64.",
+		)
+	}
+
+	resp.Diagnostics.Append(common.ValidateIfExists(config.IfExists, resourceMetadataType)...)
+}
+
+// ModifyPlan computes the hash of the configured icon, so that changes to the content of icon_file are detected.
+// Uploading an icon requires the ROLE_ICONS guild feature, and changing a role requires it to be below the
+// highest role of the bot, which are both checked before anything is sent.
+func (r *RoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client != nil && common.PlanChanged(req.Plan.Raw, req.State.Raw, "last_updated") {
+		resp.Diagnostics.Append(r.checkHierarchy(ctx, req)...)
+	}
+
+	// Nothing else to do when the resource is destroyed
+	if req.Plan.Raw.IsNull() || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan, state RoleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The tags of a role do not change when the role is updated
+	if !req.State.Raw.IsNull() {
+		plan.Tags = state.Tags
+	}
+
+	if plan.IconFile.IsUnknown() || plan.IconBase64.IsUnknown() {
+		plan.IconHash = types.StringUnknown()
+		plan.Icon = types.StringUnknown()
+
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	icon, err := readIcon(&plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Invalid %s Configuration", resourceMetadataType),
+			err.Error(),
+		)
+
+		return
+	}
+
+	plan.IconHash = iconHash(icon)
+
+	if !plan.IconHash.Equal(state.IconHash) {
+		// The icon changes, so its hash is only known after apply
+		plan.Icon = types.StringUnknown()
+
+		if icon != nil && r.client != nil && !plan.GuildID.IsUnknown() {
+			resp.Diagnostics.Append(r.checkRoleIcons(ctx, plan.GuildID.ValueString())...)
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// checkHierarchy checks that the role is below the highest role of the bot.
+// A created role is placed at the bottom of the hierarchy, so only existing roles are checked.
+func (r *RoleResource) checkHierarchy(ctx context.Context, req resource.ModifyPlanRequest) diag.Diagnostics {
+	if req.State.Raw.IsNull() {
+		return nil
+	}
+
+	var state RoleResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	if diags.HasError() {
+		return diags
+	}
+
+	guildID := state.GuildID.ValueString()
+
+	roles, highest, err := fetchBotHierarchy(ctx, r.client, guildID)
+	if err != nil {
+		diags.AddError("Failed to check the role hierarchy", err.Error())
+		return diags
+	}
+
+	diags.Append(checkRoles(roles, highest, guildID, []string{state.ID.ValueString()})...)
+
+	return diags
+}
+
+// checkRoleIcons checks that the guild has the ROLE_ICONS feature, which is required to upload role icons.
+func (r *RoleResource) checkRoleIcons(ctx context.Context, guildID string) diag.Diagnostics {
+	result, err := guild.FetchByID(ctx, r.client, guildID)
+	if err != nil {
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic(fmt.Sprintf("Failed to get guild for %s", resourceMetadataName), err.Error()),
+		}
+	}
+
+	if !slices.Contains(result.Features, discordgo.GuildFeatureRoleIcons) {
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				fmt.Sprintf("Invalid %s Configuration", resourceMetadataType),
+				fmt.Sprintf("The guild %s does not have the %s feature, which is required to set a role icon.", guildID, discordgo.GuildFeatureRoleIcons),
+			),
+		}
+	}
+
+	return nil
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *RoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	ctx = tflog.SetField(ctx, "operation", "create")
@@ -186,8 +349,48 @@ func (r *RoleResource) Create(ctx context.Context, req resource.CreateRequest, r
 	// Setup the role parameters
 	roleParams := setupParams(&plan, permissions)
 
-	// Create the resource
-	result, err := role.Create(ctx, r.client, guild_id, roleParams)
+	icon, err := readIcon(&plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Invalid %s Configuration", resourceMetadataType),
+			err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if icon != nil {
+		dataURI := iconDataURI(icon)
+		roleParams.Icon = &dataURI
+	}
+
+	plan.IconHash = iconHash(icon)
+
+	existing, existingTags, err := findExisting(ctx, r.client, guild_id, plan.Name.ValueString(), plan.IfExists.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to create %s", resourceMetadataName),
+			err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result *discordgo.Role
+
+	// Create the resource, or adopt the existing role
+	if existing != nil {
+		tflog.Info(ctx, fmt.Sprintf("Adopting existing %s %s: %s", resourceMetadataName, resourceMetadataType, existing.ID))
+
+		result, err = role.UpdateByID(ctx, r.client, guild_id, existing.ID, roleParams)
+	} else {
+		result, err = role.Create(ctx, r.client, guild_id, roleParams)
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Failed to create %s", resourceMetadataName),
@@ -201,10 +404,22 @@ func (r *RoleResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	tflog.Info(ctx, fmt.Sprintf("Reading plan %s %s: %v", resourceMetadataName, resourceMetadataType, plan))
 
+	// The deprecated position is not sent to Discord, keep the planned value
+	position := plan.Position
+
 	if diags := UpdateModel(result, &plan, nil); diags != nil {
 		resp.Diagnostics.Append(diags...)
 	}
 
+	if !position.IsUnknown() {
+		plan.Position = position
+	}
+
+	// A created role has no tags, an adopted role keeps the tags it was listed with
+	tags, diags := ToRoleTags(existingTags)
+	resp.Diagnostics.Append(diags...)
+	plan.Tags = tags
+
 	// Set the LastUpdated field to the current time.
 	plan.LastUpdated = types.StringValue(common.CurrentTime())
 
@@ -277,8 +492,34 @@ func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	// Setup the role parameters
 	roleParams := setupParams(&plan, permissions)
 
+	icon, err := readIcon(&plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Invalid %s Configuration", resourceMetadataType),
+			err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.IconHash = iconHash(icon)
+
+	// Only upload the icon when it changed
+	if icon != nil && !plan.IconHash.Equal(state.IconHash) {
+		dataURI := iconDataURI(icon)
+		roleParams.Icon = &dataURI
+	}
+
 	// Update the resource
 	result, err := role.UpdateByID(ctx, r.client, guild_id, state.ID.ValueString(), roleParams)
+
+	// Clear the icon when it was removed from the configuration
+	if err == nil && icon == nil && !state.IconHash.IsNull() {
+		result, err = clearIcon(ctx, r.client, guild_id, state.ID.ValueString())
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Failed to update %s", resourceMetadataName),
@@ -293,10 +534,17 @@ func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	tflog.Info(ctx, fmt.Sprintf("Reading provided %s %s: %v", resourceMetadataName, resourceMetadataType, plan))
 	tflog.Info(ctx, fmt.Sprintf("Reading state %s %s: %v", resourceMetadataName, resourceMetadataType, state))
 
+	// The deprecated position is not sent to Discord, keep the planned value
+	position := plan.Position
+
 	if diags := UpdateModel(result, &plan, nil); diags != nil {
 		resp.Diagnostics.Append(diags...)
 	}
 
+	if !position.IsUnknown() {
+		plan.Position = position
+	}
+
 	// Set the LastUpdated field to the current time.
 	plan.LastUpdated = types.StringValue(common.CurrentTime())
 
@@ -358,7 +606,12 @@ func (r *RoleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 		// Likely, we shouldn't reach this point as the role wouldn't be in the Terraform state,
 		// but it's here for completeness.
-		err = role.DeleteByName(ctx, r.client, guild_id, state.Name.ValueString())
+		var result *discordgo.Role
+
+		result, err = FetchByName(ctx, r.client, guild_id, state.Name.ValueString())
+		if err == nil {
+			err = role.DeleteByID(ctx, r.client, guild_id, result.ID)
+		}
 	} else {
 		err = fmt.Errorf("either the id or the name must be set for the %s %s", resourceMetadataName, resourceMetadataType)
 	}
@@ -444,18 +697,20 @@ func (r *RoleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	guild_id := provided.GuildID.ValueString()
 
 	var result *discordgo.Role
-	var err error
 
-	// Fetch data from the Discord client
-	if id != "" {
-		result, err = role.FetchByID(ctx, r.client, guild_id, id)
-	} else if name != "" {
-		result, err = role.FetchByName(ctx, r.client, guild_id, name)
-	} else {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Invalid %s Configuration", resourceMetadataType),
-			fmt.Sprintf("Either the id or the name must be set for the %s %s.", resourceMetadataName, resourceMetadataType),
-		)
+	// Fetch data from the Discord client, the roles are listed with their tags
+	roles, tags, err := FetchAllWithTags(ctx, r.client, guild_id)
+	if err == nil {
+		if id != "" {
+			result, err = findByID(roles, id)
+		} else if name != "" {
+			result, err = common.SelectUnique("role", name, filterByName(roles, name), roleID)
+		} else {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Invalid %s Configuration", resourceMetadataType),
+				fmt.Sprintf("Either the id or the name must be set for the %s %s.", resourceMetadataName, resourceMetadataType),
+			)
+		}
 	}
 
 	if err != nil {
@@ -476,6 +731,10 @@ func (r *RoleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		resp.Diagnostics.Append(diags...)
 	}
 
+	tagsObject, diags := ToRoleTags(tags[result.ID])
+	resp.Diagnostics.Append(diags...)
+	state.Tags = tagsObject
+
 	tflog.Info(ctx, fmt.Sprintf("Updated state %s %s: %v", resourceMetadataName, resourceMetadataType, state))
 
 	if resp.Diagnostics.HasError() {
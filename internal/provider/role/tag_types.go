@@ -0,0 +1,162 @@
+package role
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type RoleTags struct {
+	// The ID of the bot this role belongs to.
+	BotID types.String `tfsdk:"bot_id"`
+
+	// The ID of the integration this role belongs to.
+	IntegrationID types.String `tfsdk:"integration_id"`
+
+	// Whether this is the guild's Booster role.
+	PremiumSubscriber types.Bool `tfsdk:"premium_subscriber"`
+
+	// The ID of this role's subscription SKU and listing.
+	SubscriptionListingID types.String `tfsdk:"subscription_listing_id"`
+
+	// Whether this role is available for purchase.
+	AvailableForPurchase types.Bool `tfsdk:"available_for_purchase"`
+
+	// Whether this role is a guild's linked role.
+	GuildConnections types.Bool `tfsdk:"guild_connections"`
+}
+
+var RoleTagsAttrTypes = map[string]attr.Type{
+	"bot_id":                  types.StringType,
+	"integration_id":          types.StringType,
+	"premium_subscriber":      types.BoolType,
+	"subscription_listing_id": types.StringType,
+	"available_for_purchase":  types.BoolType,
+	"guild_connections":       types.BoolType,
+}
+
+var RoleTagsResourceSchema = map[string]resourceschema.Attribute{
+	"bot_id": resourceschema.StringAttribute{
+		Description: "The ID of the bot this role belongs to, if the role is managed by a bot.",
+		Computed:    true,
+	},
+	"integration_id": resourceschema.StringAttribute{
+		Description: "The ID of the integration this role belongs to, if the role is managed by an integration.",
+		Computed:    true,
+	},
+	"premium_subscriber": resourceschema.BoolAttribute{
+		Description: "Whether this is the Server Booster role of the guild.",
+		Computed:    true,
+	},
+	"subscription_listing_id": resourceschema.StringAttribute{
+		Description: "The ID of the subscription SKU and listing of the role, if the role is a subscription role.",
+		Computed:    true,
+	},
+	"available_for_purchase": resourceschema.BoolAttribute{
+		Description: "Whether the role is available for purchase.",
+		Computed:    true,
+	},
+	"guild_connections": resourceschema.BoolAttribute{
+		Description: "Whether the role is a linked role of the guild.",
+		Computed:    true,
+	},
+}
+
+var RoleTagsSchema = map[string]schema.Attribute{
+	"bot_id": schema.StringAttribute{
+		Description: "The ID of the bot this role belongs to, if the role is managed by a bot.",
+		Computed:    true,
+	},
+	"integration_id": schema.StringAttribute{
+		Description: "The ID of the integration this role belongs to, if the role is managed by an integration.",
+		Computed:    true,
+	},
+	"premium_subscriber": schema.BoolAttribute{
+		Description: "Whether this is the Server Booster role of the guild.",
+		Computed:    true,
+	},
+	"subscription_listing_id": schema.StringAttribute{
+		Description: "The ID of the subscription SKU and listing of the role, if the role is a subscription role.",
+		Computed:    true,
+	},
+	"available_for_purchase": schema.BoolAttribute{
+		Description: "Whether the role is available for purchase.",
+		Computed:    true,
+	},
+	"guild_connections": schema.BoolAttribute{
+		Description: "Whether the role is a linked role of the guild.",
+		Computed:    true,
+	},
+}
+
+// RawRoleTags holds the tags of a role as returned by Discord.
+// The boolean tags are sent as null when they are set, and omitted otherwise.
+type RawRoleTags map[string]json.RawMessage
+
+// FetchAllWithTags fetches the roles of the guild, and their tags by role ID, with a single request.
+// discordgo does not decode the role tags, so they are decoded from the same raw response as the roles.
+func FetchAllWithTags(ctx context.Context, client *discordgo.Session, guildID string) ([]*discordgo.Role, map[string]RawRoleTags, error) {
+	endpoint := discordgo.EndpointGuildRoles(guildID)
+
+	body, err := client.RequestWithBucketID("GET", endpoint, nil, endpoint, discordgo.WithContext(ctx))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var roles []*discordgo.Role
+	if err := json.Unmarshal(body, &roles); err != nil {
+		return nil, nil, err
+	}
+
+	var raw []struct {
+		ID   string      `json:"id"`
+		Tags RawRoleTags `json:"tags"`
+	}
+
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, nil, err
+	}
+
+	tags := make(map[string]RawRoleTags, len(raw))
+	for _, r := range raw {
+		tags[r.ID] = r.Tags
+	}
+
+	return roles, tags, nil
+}
+
+// tagString returns the string value of the tag, or a null value when the tag is not set.
+func tagString(tags RawRoleTags, key string) types.String {
+	var value string
+
+	if err := json.Unmarshal(tags[key], &value); err != nil || value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
+
+// tagBool returns whether the tag is present.
+func tagBool(tags RawRoleTags, key string) types.Bool {
+	_, ok := tags[key]
+
+	return types.BoolValue(ok)
+}
+
+// ToRoleTags converts the tags of a role to the schema data.
+func ToRoleTags(tags RawRoleTags) (types.Object, diag.Diagnostics) {
+	return types.ObjectValueFrom(context.Background(), RoleTagsAttrTypes, RoleTags{
+		BotID:                 tagString(tags, "bot_id"),
+		IntegrationID:         tagString(tags, "integration_id"),
+		PremiumSubscriber:     tagBool(tags, "premium_subscriber"),
+		SubscriptionListingID: tagString(tags, "subscription_listing_id"),
+		AvailableForPurchase:  tagBool(tags, "available_for_purchase"),
+		GuildConnections:      tagBool(tags, "guild_connections"),
+	})
+}
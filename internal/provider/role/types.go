@@ -2,6 +2,7 @@ package role
 
 import (
 	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -10,6 +11,14 @@ type RoleDataSource struct {
 	client *discordgo.Session
 }
 
+// RoleLookupModel maps the data source schema data, including the attributes used only to look up the role.
+type RoleLookupModel struct {
+	// The index of the role to select when several roles share the name.
+	MatchIndex types.Int32 `tfsdk:"match_index"`
+
+	RoleDataSourceModel
+}
+
 // RoleDataSourceModel maps the data source schema data.
 type RoleDataSourceModel struct {
 	// GuildID is the ID of the guild.
@@ -49,7 +58,7 @@ type RoleDataSourceModel struct {
 	Mentionable types.Bool `tfsdk:"mentionable"`
 
 	// The tags this role has
-	// Tags RoleTags `tfsdk:"tags"`
+	Tags types.Object `tfsdk:"tags"`
 
 	// The flags of the role, which describe its extra features.
 	// This is a combination of bit masks; the presence of a certain flag can
@@ -68,5 +77,78 @@ type RoleResourceModel struct {
 	// LastUpdated is the last time the resource was updated.
 	LastUpdated types.String `tfsdk:"last_updated"`
 
+	// The path of an image file to upload as the role icon.
+	IconFile types.String `tfsdk:"icon_file"`
+
+	// The base64 encoded image to upload as the role icon.
+	IconBase64 types.String `tfsdk:"icon_base64"`
+
+	// The SHA-256 hash of the uploaded icon image, used to detect changes.
+	IconHash types.String `tfsdk:"icon_hash"`
+
+	// What to do on create when a role with the configured name already exists.
+	IfExists types.String `tfsdk:"if_exists"`
+
 	RoleDataSourceModel
 }
+
+// RoleSchema defines the schema for a role nested in other data sources.
+var RoleSchema = schema.NestedAttributeObject{
+	Attributes: map[string]schema.Attribute{
+		"guild_id": schema.StringAttribute{
+			Description: "The ID of the guild.",
+			Computed:    true,
+		},
+		"id": schema.StringAttribute{
+			Description: "The ID of the role.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "The name of the role.",
+			Computed:    true,
+		},
+		"managed": schema.BoolAttribute{
+			Description: "Whether this role is managed by an integration, and thus cannot be manually added to, or taken from, members.",
+			Computed:    true,
+		},
+		"mentionable": schema.BoolAttribute{
+			Description: "Whether this role is mentionable.",
+			Computed:    true,
+		},
+		"hoist": schema.BoolAttribute{
+			Description: "Whether this role is hoisted (shows up separately in member list).",
+			Computed:    true,
+		},
+		"color": schema.StringAttribute{
+			Description: "The hex color of this role.",
+			Computed:    true,
+		},
+		"position": schema.Int32Attribute{
+			Description: "The position of this role in the guild's role hierarchy.",
+			Computed:    true,
+		},
+		"permissions": schema.ListAttribute{
+			Description: "The permissions of the role on the guild (doesn't include channel overrides).",
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+		"icon": schema.StringAttribute{
+			Description: "The hash of the role icon. Use Role.IconURL to retrieve the icon's URL.",
+			Computed:    true,
+		},
+		"unicode_emoji": schema.StringAttribute{
+			Description: "The emoji assigned to this role.",
+			Computed:    true,
+		},
+		"flags": schema.ListAttribute{
+			Description: "The flags of the role, which describe its extra features.",
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+		"tags": schema.SingleNestedAttribute{
+			Description: "The tags of the role, which tell bot, integration, booster and subscription roles apart.",
+			Computed:    true,
+			Attributes:  RoleTagsSchema,
+		},
+	},
+}
@@ -1,6 +1,17 @@
 package role
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/JustARecord/go-discordutils/base/role"
 	discord "github.com/JustARecord/go-discordutils/utils"
 	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
 	"github.com/bwmarrin/discordgo"
@@ -39,11 +50,72 @@ func setupParams(model *RoleResourceModel, permissions []string) *discordgo.Role
 		roleParams.UnicodeEmoji = model.UnicodeEmoji.ValueStringPointer()
 	}
 
-	if !model.Icon.IsNull() {
-		roleParams.Icon = model.Icon.ValueStringPointer()
+	return roleParams
+}
+
+// readIcon reads the icon image of the model, from either icon_file or icon_base64.
+// A nil value is returned when no icon is set.
+func readIcon(model *RoleResourceModel) ([]byte, error) {
+	if !model.IconFile.IsNull() {
+		data, err := os.ReadFile(model.IconFile.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read icon_file: %w", err)
+		}
+
+		return data, nil
 	}
 
-	return roleParams
+	if !model.IconBase64.IsNull() {
+		value := model.IconBase64.ValueString()
+
+		// Accept data URIs as well as raw base64
+		if _, after, ok := strings.Cut(value, ";base64,"); ok && strings.HasPrefix(value, "data:") {
+			value = after
+		}
+
+		data, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode icon_base64: %w", err)
+		}
+
+		return data, nil
+	}
+
+	return nil, nil
+}
+
+// iconHash returns the hash of the icon image, or a null value when no icon is set.
+func iconHash(data []byte) types.String {
+	if data == nil {
+		return types.StringNull()
+	}
+
+	sum := sha256.Sum256(data)
+
+	return types.StringValue(hex.EncodeToString(sum[:]))
+}
+
+// iconDataURI encodes the icon image as a data URI, the format Discord expects for image uploads.
+func iconDataURI(data []byte) string {
+	return fmt.Sprintf("data:%s;base64,%s", http.DetectContentType(data), base64.StdEncoding.EncodeToString(data))
+}
+
+// clearIcon removes the icon of the role.
+// RoleParams omits empty icons, so the null value is sent with a raw request.
+func clearIcon(ctx context.Context, client *discordgo.Session, guildID, roleID string) (*discordgo.Role, error) {
+	body, err := client.RequestWithBucketID("PATCH", discordgo.EndpointGuildRole(guildID, roleID), map[string]any{
+		"icon": nil,
+	}, discordgo.EndpointGuildRole(guildID, ""), discordgo.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	var result *discordgo.Role
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
 }
 
 // UpdateModel updates the role resource model with the provided role.
@@ -96,5 +168,72 @@ func UpdateModel(role *discordgo.Role, model, state *RoleResourceModel) diag.Dia
 	// Map the guild data to the state.
 	model.GuildID = state.GuildID
 
+	// Map the configuration data to the state, as it is not returned by Discord.
+	model.IconFile = state.IconFile
+	model.IconBase64 = state.IconBase64
+	model.IconHash = state.IconHash
+	model.IfExists = state.IfExists
+
 	return nil
 }
+
+// ToDataSourceModel converts the provided role and its tags to the data source model.
+func ToDataSourceModel(result *discordgo.Role, guildID string, tags RawRoleTags) (*RoleDataSourceModel, diag.Diagnostics) {
+	permissions := discord.ListStringify(result.Permissions)
+	flags := discord.ListStringify(result.Flags)
+
+	permissionsList, diags := common.ToListType[string, basetypes.StringType](permissions)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	flagsList, diags := common.ToListType[string, basetypes.StringType](flags)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	tagsObject, diags := ToRoleTags(tags)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &RoleDataSourceModel{
+		GuildID:      types.StringValue(guildID),
+		ID:           types.StringValue(result.ID),
+		Name:         types.StringValue(result.Name),
+		Managed:      types.BoolValue(result.Managed),
+		Mentionable:  types.BoolValue(result.Mentionable),
+		Hoist:        types.BoolValue(result.Hoist),
+		Color:        types.StringValue(common.StrHex(result.Color)),
+		Position:     types.Int32Value(int32(result.Position)),
+		Permissions:  permissionsList,
+		Icon:         types.StringValue(result.Icon),
+		UnicodeEmoji: types.StringValue(result.UnicodeEmoji),
+		Flags:        flagsList,
+		Tags:         tagsObject,
+	}, nil
+}
+
+// findExisting returns the role to adopt on create, or nil when a new role should be created.
+func findExisting(ctx context.Context, client *discordgo.Session, guildID, name, ifExists string) (*discordgo.Role, RawRoleTags, error) {
+	if ifExists != common.IfExistsAdopt && ifExists != common.IfExistsError {
+		return nil, nil, nil
+	}
+
+	roles, tags, err := FetchAllWithTags(ctx, client, guildID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	existing := filterByName(roles, name)
+
+	if len(existing) == 0 {
+		return nil, nil, nil
+	}
+
+	if ifExists == common.IfExistsError || len(existing) > 1 {
+		return nil, nil, common.ExistingError("role", name, role.IDs(existing))
+	}
+
+	return existing[0], tags[existing[0].ID], nil
+}
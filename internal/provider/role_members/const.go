@@ -23,7 +23,9 @@ const (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &RoleMembersResource{}
-	_ resource.ResourceWithConfigure   = &RoleMembersResource{}
-	_ resource.ResourceWithImportState = &RoleMembersResource{}
+	_ resource.Resource                   = &RoleMembersResource{}
+	_ resource.ResourceWithConfigure      = &RoleMembersResource{}
+	_ resource.ResourceWithImportState    = &RoleMembersResource{}
+	_ resource.ResourceWithValidateConfig = &RoleMembersResource{}
+	_ resource.ResourceWithModifyPlan     = &RoleMembersResource{}
 )
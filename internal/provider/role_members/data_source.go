@@ -7,6 +7,7 @@ import (
 	"github.com/JustARecord/go-discordutils/base/member"
 	"github.com/JustARecord/go-discordutils/base/role"
 	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
+	tfrole "github.com/TheCodedCloud/terraform-provider-discord/internal/provider/role"
 	"github.com/bwmarrin/discordgo"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -95,7 +96,7 @@ func (d *RoleMembersDataSource) Read(ctx context.Context, req datasource.ReadReq
 	if role_id != "" {
 		result_role, err = role.FetchByID(ctx, d.client, guild_id, role_id)
 	} else if role_name != "" {
-		result_role, err = role.FetchByName(ctx, d.client, guild_id, role_name)
+		result_role, err = tfrole.FetchByName(ctx, d.client, guild_id, role_name)
 	} else {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Invalid %s Configuration", datasourceMetadataType),
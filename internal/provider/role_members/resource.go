@@ -2,15 +2,19 @@ package role_members
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
-	"github.com/JustARecord/go-discordutils/base/guild"
 	"github.com/JustARecord/go-discordutils/base/role"
 	discord "github.com/JustARecord/go-discordutils/utils"
 	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
+	tfmember "github.com/TheCodedCloud/terraform-provider-discord/internal/provider/member"
+	tfrole "github.com/TheCodedCloud/terraform-provider-discord/internal/provider/role"
 	"github.com/bwmarrin/discordgo"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -63,7 +67,7 @@ func (r *RoleMembersResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Computed:    true,
 			},
 			"members": schema.ListAttribute{
-				Description: "Array of role members",
+				Description: "The usernames of the members of the role. Usernames can change and collide, prefer member_ids. Cannot be used with member_ids.",
 				Computed:    true,
 				Optional:    true,
 				ElementType: types.StringType,
@@ -71,10 +75,239 @@ func (r *RoleMembersResource) Schema(_ context.Context, _ resource.SchemaRequest
 					listplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"member_ids": schema.SetAttribute{
+				Description: "The user IDs of the members of the role. Cannot be used with members.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"mode": schema.StringAttribute{
+				Description: fmt.Sprintf("Either '%s', which removes the role from any member that is not configured, or '%s', "+
+					"which only adds the role to the configured members, and removes it from the members it was added to when they are no longer configured or the resource is destroyed. "+
+					"Defaults to '%s'.", common.ModeAuthoritative, common.ModeAdditive, common.ModeAuthoritative),
+				Optional: true,
+			},
+			"added_member_ids": schema.SetAttribute{
+				Description: "The user IDs of the members the role was added to by this resource, which the role is removed from on destroy. " +
+					"In additive mode, members that already held the role are not included.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
 		},
 	}
 }
 
+// ValidateConfig validates the resource configuration.
+func (r *RoleMembersResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config RoleMembersResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Members.IsNull() && !config.MemberIDs.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("member_ids"),
+			fmt.Sprintf("Invalid %s Configuration", resourceMetadataType),
+			"members cannot be used together with member_ids.",
+		)
+	}
+
+	resp.Diagnostics.Append(common.ValidateMode(config.Mode, resourceMetadataType)...)
+}
+
+// ModifyPlan checks that the role is below the highest role of the bot, as Discord rejects assigning it otherwise.
+// The usernames of the members are only known after apply when the member IDs change.
+func (r *RoleMembersResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !common.PlanChanged(req.Plan.Raw, req.State.Raw, "last_updated") {
+		return
+	}
+
+	if !req.Plan.Raw.IsNull() && !req.State.Raw.IsNull() {
+		var config, plan, state RoleMembersResourceModel
+
+		resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if config.Members.IsNull() && !plan.MemberIDs.Equal(state.MemberIDs) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("members"), types.ListUnknown(types.StringType))...)
+		}
+	}
+
+	if r.client == nil || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var model RoleMembersResourceModel
+
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	} else {
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	}
+
+	if resp.Diagnostics.HasError() || model.GuildID.IsUnknown() {
+		return
+	}
+
+	guild_id := model.GuildID.ValueString()
+	role_id := model.RoleID
+
+	// The role is looked up by name when only its name is known, the lookup errors are reported on apply.
+	if role_id.IsNull() || role_id.IsUnknown() {
+		if model.Role.IsNull() || model.Role.IsUnknown() {
+			return
+		}
+
+		result_role, err := tfrole.FetchByName(ctx, r.client, guild_id, model.Role.ValueString())
+		if err != nil {
+			return
+		}
+
+		role_id = types.StringValue(result_role.ID)
+	}
+
+	resp.Diagnostics.Append(tfrole.CheckBotHierarchy(ctx, r.client, guild_id, []string{role_id.ValueString()})...)
+}
+
+// fetchRole fetches the role of the model, by ID or by name.
+func (r *RoleMembersResource) fetchRole(ctx context.Context, guildID string, model *RoleMembersResourceModel) (*discordgo.Role, error) {
+	if !model.RoleID.IsNull() && !model.RoleID.IsUnknown() {
+		return tfrole.FetchByID(ctx, r.client, guildID, model.RoleID.ValueString())
+	}
+
+	if !model.Role.IsNull() && !model.Role.IsUnknown() {
+		return tfrole.FetchByName(ctx, r.client, guildID, model.Role.ValueString())
+	}
+
+	return nil, fmt.Errorf("either the role_id or the role must be set for the %s %s", resourceMetadataName, resourceMetadataType)
+}
+
+// desiredMembers returns the members that should hold the role, from member_ids, or by looking up the usernames in members.
+// Members that are not in the guild are skipped.
+func (r *RoleMembersResource) desiredMembers(ctx context.Context, guildID string, model *RoleMembersResourceModel) ([]*discordgo.Member, diag.Diagnostics) {
+	if model.MemberIDs.IsNull() {
+		if model.Members.IsNull() || model.Members.IsUnknown() {
+			return []*discordgo.Member{}, nil
+		}
+
+		names, diags := common.FromListType(ctx, model.Members)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		members, err := tfmember.FetchByNames(ctx, r.client, guildID, names)
+		if err != nil {
+			return nil, errorDiagnostics("Failed to get members", err)
+		}
+
+		return members, nil
+	}
+
+	ids, diags := fromSet(ctx, model.MemberIDs)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	members := make([]*discordgo.Member, 0, len(ids))
+
+	for _, id := range ids {
+		m, err := r.client.GuildMember(guildID, id, discordgo.WithContext(ctx))
+		if err != nil {
+			if discord.NotFoundError(err) {
+				continue
+			}
+
+			return nil, errorDiagnostics(fmt.Sprintf("Failed to get member %s", id), err)
+		}
+
+		members = append(members, m)
+	}
+
+	return members, nil
+}
+
+// apply assigns the role to the desired members, and returns the IDs of the members the role was added to by the resource.
+// In authoritative mode, the role is removed from any other member. In additive mode, the role is only removed
+// from the previously added members that are no longer desired.
+func (r *RoleMembersResource) apply(ctx context.Context, guildID string, result_role *discordgo.Role, desired []*discordgo.Member, previousAdded []string, additive bool) ([]string, error) {
+	desiredIDs := memberIDs(desired)
+
+	if !additive {
+		holders, err := role.FetchMembers(ctx, r.client, guildID, result_role.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		holderIDs := memberIDs(holders)
+
+		for _, id := range holderIDs {
+			if slices.Contains(desiredIDs, id) {
+				continue
+			}
+
+			if err := r.client.GuildMemberRoleRemove(guildID, id, result_role.ID, discordgo.WithContext(ctx)); err != nil && !discord.NotFoundError(err) {
+				return nil, err
+			}
+		}
+
+		for _, id := range desiredIDs {
+			if slices.Contains(holderIDs, id) {
+				continue
+			}
+
+			if err := r.client.GuildMemberRoleAdd(guildID, id, result_role.ID, discordgo.WithContext(ctx)); err != nil {
+				return nil, err
+			}
+		}
+
+		return desiredIDs, nil
+	}
+
+	added := []string{}
+
+	for _, m := range desired {
+		if slices.Contains(m.Roles, result_role.ID) {
+			// Members that already held the role are only tracked if the resource added it
+			if slices.Contains(previousAdded, m.User.ID) {
+				added = append(added, m.User.ID)
+			}
+
+			continue
+		}
+
+		if err := r.client.GuildMemberRoleAdd(guildID, m.User.ID, result_role.ID, discordgo.WithContext(ctx)); err != nil {
+			return nil, err
+		}
+
+		added = append(added, m.User.ID)
+	}
+
+	for _, id := range previousAdded {
+		if slices.Contains(desiredIDs, id) {
+			continue
+		}
+
+		if err := r.client.GuildMemberRoleRemove(guildID, id, result_role.ID, discordgo.WithContext(ctx)); err != nil && !discord.NotFoundError(err) {
+			return nil, err
+		}
+	}
+
+	return added, nil
+}
+
+// errorDiagnostics returns the error as diagnostics for the resource.
+func errorDiagnostics(summary string, err error) diag.Diagnostics {
+	return diag.Diagnostics{
+		diag.NewErrorDiagnostic(fmt.Sprintf("%s for %s", summary, resourceMetadataName), err.Error()),
+	}
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *RoleMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	ctx = tflog.SetField(ctx, "operation", "create")
@@ -106,48 +339,10 @@ func (r *RoleMembersResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	members_names := []string{}
-
-	// Set the members
-	if !plan.Members.IsNull() {
-		members_names, diags = common.FromListType(ctx, plan.Members)
-
-		resp.Diagnostics.Append(diags...)
-	}
-
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
 	guild_id := plan.GuildID.ValueString()
 
-	// Fetch the guild
-	result_guild, err := guild.FetchByID(ctx, r.client, guild_id)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Failed to get %s", resourceMetadataName),
-			err.Error(),
-		)
-	}
-
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
 	// Fetch the role
-	var result_role *discordgo.Role
-
-	if !plan.RoleID.IsNull() {
-		result_role, err = role.FetchByID(ctx, r.client, guild_id, plan.RoleID.ValueString())
-	} else if !plan.Role.IsNull() {
-		result_role, err = role.FetchByName(ctx, r.client, guild_id, plan.Role.ValueString())
-	} else {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Invalid %s Configuration", resourceMetadataType),
-			fmt.Sprintf("Either the role_id or the role must be set for the %s %s.", resourceMetadataName, resourceMetadataType),
-		)
-	}
-
+	result_role, err := r.fetchRole(ctx, guild_id, &plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Failed to get %s", resourceMetadataName),
@@ -160,20 +355,14 @@ func (r *RoleMembersResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	// Fetch the members
-	members, err := guild.FetchMembersByName(ctx, r.client, result_guild, members_names)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Failed to get members for %s", resourceMetadataName),
-			err.Error(),
-		)
-	}
-
+	members, diags := r.desiredMembers(ctx, guild_id, &plan)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Create the resource
-	result, err := role.SetMembers(ctx, r.client, result_guild, result_role, members)
+	added, err := r.apply(ctx, guild_id, result_role, members, []string{}, isAdditive(&plan))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Failed to create %s", resourceMetadataName),
@@ -187,7 +376,7 @@ func (r *RoleMembersResource) Create(ctx context.Context, req resource.CreateReq
 
 	tflog.Info(ctx, fmt.Sprintf("Reading plan %s %s: %v", resourceMetadataName, resourceMetadataType, plan))
 
-	if diags := UpdateModel(result_role, result, &plan, nil); diags != nil {
+	if diags := UpdateModel(ctx, result_role, members, added, &plan, nil); diags != nil {
 		resp.Diagnostics.Append(diags...)
 	}
 
@@ -245,48 +434,10 @@ func (r *RoleMembersResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	members_names := []string{}
-
-	// Set the members
-	if !plan.Members.IsNull() {
-		members_names, diags = common.FromListType(ctx, plan.Members)
-
-		resp.Diagnostics.Append(diags...)
-	}
-
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
 	guild_id := plan.GuildID.ValueString()
 
-	// Fetch the guild
-	result_guild, err := guild.FetchByID(ctx, r.client, guild_id)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Failed to get %s", resourceMetadataName),
-			err.Error(),
-		)
-	}
-
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
 	// Fetch the role
-	var result_role *discordgo.Role
-
-	if !plan.RoleID.IsNull() {
-		result_role, err = role.FetchByID(ctx, r.client, guild_id, plan.RoleID.ValueString())
-	} else if !state.Role.IsNull() {
-		result_role, err = role.FetchByName(ctx, r.client, guild_id, state.Role.ValueString())
-	} else {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Invalid %s Configuration", resourceMetadataType),
-			fmt.Sprintf("Either the role_id or the role must be set for the %s %s.", resourceMetadataName, resourceMetadataType),
-		)
-	}
-
+	result_role, err := r.fetchRole(ctx, guild_id, &plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Failed to get %s", resourceMetadataName),
@@ -299,20 +450,18 @@ func (r *RoleMembersResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	// Fetch the members
-	members, err := guild.FetchMembersByName(ctx, r.client, result_guild, members_names)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Failed to get members for %s", resourceMetadataName),
-			err.Error(),
-		)
-	}
+	members, diags := r.desiredMembers(ctx, guild_id, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	previousAdded, diags := fromSet(ctx, state.AddedMemberIDs)
+	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Update the resource
-	result, err := role.SetMembers(ctx, r.client, result_guild, result_role, members)
+	added, err := r.apply(ctx, guild_id, result_role, members, previousAdded, isAdditive(&plan))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Failed to update %s", resourceMetadataName),
@@ -327,7 +476,7 @@ func (r *RoleMembersResource) Update(ctx context.Context, req resource.UpdateReq
 	tflog.Info(ctx, fmt.Sprintf("Reading provided %s %s: %v", resourceMetadataName, resourceMetadataType, plan))
 	tflog.Info(ctx, fmt.Sprintf("Reading state %s %s: %v", resourceMetadataName, resourceMetadataType, state))
 
-	if diags := UpdateModel(result_role, result, &plan, nil); diags != nil {
+	if diags := UpdateModel(ctx, result_role, members, added, &plan, nil); diags != nil {
 		resp.Diagnostics.Append(diags...)
 	}
 
@@ -348,7 +497,7 @@ func (r *RoleMembersResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 }
 
-// Delete deletes the resource and removes the Terraform state on success.
+// Delete removes the role from the members it was added to, and removes the Terraform state on success.
 func (r *RoleMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	ctx = tflog.SetField(ctx, "operation", "delete")
 
@@ -379,24 +528,16 @@ func (r *RoleMembersResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	members_names := []string{}
-
-	// Set the members
-	if !state.Members.IsNull() {
-		members_names, diags = common.FromListType(ctx, state.Members)
-
-		resp.Diagnostics.Append(diags...)
-	}
-
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
 	guild_id := state.GuildID.ValueString()
 
-	// Fetch the guild
-	result_guild, err := guild.FetchByID(ctx, r.client, guild_id)
+	// Fetch the role
+	result_role, err := r.fetchRole(ctx, guild_id, &state)
 	if err != nil {
+		// The role was deleted, so nobody holds it anymore
+		if errors.Is(err, tfrole.ErrNotFound) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Failed to get %s", resourceMetadataName),
 			err.Error(),
@@ -407,54 +548,34 @@ func (r *RoleMembersResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	// Fetch the role
-	var result_role *discordgo.Role
-
-	if !state.RoleID.IsNull() {
-		result_role, err = role.FetchByID(ctx, r.client, guild_id, state.RoleID.ValueString())
-	} else if !state.Role.IsNull() {
-		result_role, err = role.FetchByName(ctx, r.client, guild_id, state.Role.ValueString())
-	} else {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Invalid %s Configuration", resourceMetadataType),
-			fmt.Sprintf("Either the role_id or the role must be set for the %s %s.", resourceMetadataName, resourceMetadataType),
-		)
-	}
+	added, diags := fromSet(ctx, state.AddedMemberIDs)
+	resp.Diagnostics.Append(diags...)
 
-	if err != nil {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Failed to get %s", resourceMetadataName),
-			err.Error(),
-		)
-	}
+	// States written before the added members were tracked remove the role from all the members in state
+	if state.AddedMemberIDs.IsNull() {
+		var members []*discordgo.Member
 
-	if resp.Diagnostics.HasError() {
-		return
-	}
+		members, diags = r.desiredMembers(ctx, guild_id, &state)
+		resp.Diagnostics.Append(diags...)
 
-	// Fetch the members
-	members, err := guild.FetchMembersByName(ctx, r.client, result_guild, members_names)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Failed to get members for %s", resourceMetadataName),
-			err.Error(),
-		)
+		added = memberIDs(members)
 	}
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Remove the members
-	if err = role.RemoveMembers(ctx, r.client, result_guild, result_role, members); err != nil {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Failed to delete %s", resourceMetadataName),
-			err.Error(),
-		)
-	}
+	// Remove the role, ignoring members that left the guild
+	for _, id := range added {
+		err := r.client.GuildMemberRoleRemove(guild_id, id, result_role.ID, discordgo.WithContext(ctx))
+		if err != nil && !discord.NotFoundError(err) {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to delete %s", resourceMetadataName),
+				err.Error(),
+			)
 
-	if resp.Diagnostics.HasError() {
-		return
+			return
+		}
 	}
 }
 
@@ -467,7 +588,7 @@ func (r *RoleMembersResource) ImportState(ctx context.Context, req resource.Impo
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: <guild_id>/<id|name>. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: <guild_id>/<role_id|role>. Got: %q", req.ID),
 		)
 		return
 	}
@@ -484,10 +605,10 @@ func (r *RoleMembersResource) ImportState(ctx context.Context, req resource.Impo
 	// Check if the role part is an ID or a name
 	// If ID is a snowflake, it's an ID
 	if discord.IsSnowflake(resourcePart) {
-		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(resourcePart))...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_id"), types.StringValue(resourcePart))...)
 	} else {
 		// Otherwise, it's a name
-		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), types.StringValue(resourcePart))...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role"), types.StringValue(resourcePart))...)
 	}
 }
 
@@ -524,9 +645,15 @@ func (r *RoleMembersResource) Read(ctx context.Context, req resource.ReadRequest
 
 	guild_id := provided.GuildID.ValueString()
 
-	// Fetch the guild
-	result_guild, err := guild.FetchByID(ctx, r.client, guild_id)
+	// Fetch the role
+	result_role, err := r.fetchRole(ctx, guild_id, &provided)
 	if err != nil {
+		if errors.Is(err, tfrole.ErrNotFound) {
+			// The role was deleted, force a recreation and return early
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Failed to get %s", resourceMetadataName),
 			err.Error(),
@@ -537,43 +664,49 @@ func (r *RoleMembersResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	// Fetch the role
-	var result_role *discordgo.Role
-	if !provided.RoleID.IsNull() {
-		result_role, err = role.FetchByID(ctx, r.client, guild_id, provided.RoleID.ValueString())
-	} else if !provided.Role.IsNull() {
-		result_role, err = role.FetchByName(ctx, r.client, guild_id, provided.Role.ValueString())
+	var result []*discordgo.Member
+	var added []string
+
+	// Read the resource
+	if isAdditive(&provided) {
+		// Only the configured members are read, without listing the members of the guild when they are set by ID
+		members, diags := r.desiredMembers(ctx, guild_id, &provided)
+		resp.Diagnostics.Append(diags...)
+
+		previousAdded, diags := fromSet(ctx, provided.AddedMemberIDs)
+		resp.Diagnostics.Append(diags...)
+
+		for _, m := range members {
+			if !slices.Contains(m.Roles, result_role.ID) {
+				continue
+			}
+
+			result = append(result, m)
+
+			if slices.Contains(previousAdded, m.User.ID) {
+				added = append(added, m.User.ID)
+			}
+		}
 	} else {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Invalid %s Configuration", resourceMetadataType),
-			fmt.Sprintf("Either the role_id or the role must be set for the %s %s.", resourceMetadataName, resourceMetadataType),
-		)
-	}
+		result, err = role.FetchMembers(ctx, r.client, guild_id, result_role.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to read %s", resourceMetadataName),
+				err.Error(),
+			)
+		}
 
-	if err != nil {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Failed to get %s", resourceMetadataName),
-			err.Error(),
-		)
+		added = memberIDs(result)
 	}
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Read the resource
-	result, err := role.FetchMembers(ctx, r.client, result_guild.ID, result_role.ID)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Failed to read %s", resourceMetadataName),
-			err.Error(),
-		)
-	}
-
 	tflog.Info(ctx, fmt.Sprintf("Reading provided %s %s: %v", resourceMetadataName, resourceMetadataType, provided))
 	tflog.Info(ctx, fmt.Sprintf("Reading state %s %s: %v", resourceMetadataName, resourceMetadataType, state))
 
-	if diags := UpdateModel(result_role, result, &state, &provided); diags != nil {
+	if diags := UpdateModel(ctx, result_role, result, added, &state, &provided); diags != nil {
 		resp.Diagnostics.Append(diags...)
 	}
 
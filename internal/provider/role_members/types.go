@@ -35,5 +35,14 @@ type RoleMembersResourceModel struct {
 	// LastUpdated is the last time the resource was updated.
 	LastUpdated types.String `tfsdk:"last_updated"`
 
+	// The IDs of the members of the role.
+	MemberIDs types.Set `tfsdk:"member_ids"`
+
+	// Whether the resource manages all the members of the role, or only the members it adds.
+	Mode types.String `tfsdk:"mode"`
+
+	// The IDs of the members the role was added to by the resource, which it is removed from on destroy.
+	AddedMemberIDs types.Set `tfsdk:"added_member_ids"`
+
 	RoleMembersDataSourceModel
 }
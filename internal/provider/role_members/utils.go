@@ -1,6 +1,8 @@
 package role_members
 
 import (
+	"context"
+
 	"github.com/JustARecord/go-discordutils/base/member"
 	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
 	"github.com/bwmarrin/discordgo"
@@ -9,31 +11,61 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
-// UpdateModel updates the role resource model with the provided role.
-func UpdateModel(role *discordgo.Role, members []*discordgo.Member, model, state *RoleMembersResourceModel) diag.Diagnostics {
+// fromSet converts a set of strings to a list of strings. A null or unknown set is empty.
+func fromSet(ctx context.Context, set types.Set) ([]string, diag.Diagnostics) {
+	if set.IsNull() || set.IsUnknown() {
+		return []string{}, nil
+	}
+
+	elements := make([]types.String, 0, len(set.Elements()))
+
+	diags := set.ElementsAs(ctx, &elements, false)
+
+	return common.FromStringList(elements), diags
+}
+
+// isAdditive returns whether the resource only manages the members it adds the role to.
+func isAdditive(model *RoleMembersResourceModel) bool {
+	return common.IsAdditive(model.Mode)
+}
+
+// memberIDs returns the user IDs of the members.
+func memberIDs(members []*discordgo.Member) []string {
+	result := make([]string, 0, len(members))
+
+	for _, m := range members {
+		result = append(result, m.User.ID)
+	}
+
+	return result
+}
+
+// UpdateModel updates the role resource model with the provided role, the members holding it,
+// and the IDs of the members the role was added to by the resource.
+func UpdateModel(ctx context.Context, role *discordgo.Role, members []*discordgo.Member, added []string, model, state *RoleMembersResourceModel) diag.Diagnostics {
 	memberNames := member.Names(members)
 	membersList, diags := common.ToListType[string, basetypes.StringType](memberNames)
 	if diags.HasError() {
 		return diags
 	}
 
+	addedSet, diags := types.SetValueFrom(ctx, types.StringType, added)
+	if diags.HasError() {
+		return diags
+	}
+
 	if model == nil {
 		model = &RoleMembersResourceModel{}
 	}
 
 	model.RoleID = types.StringValue(role.ID)
 	model.Role = types.StringValue(role.Name)
+	model.AddedMemberIDs = addedSet
 
-	if model.Members.IsNull() {
+	if model.Members.IsNull() || model.Members.IsUnknown() {
 		model.Members = membersList
 	}
 
-	// if state != nil && !state.Members.IsNull() {
-	// 	// Revert members to the plan value
-	// 	// This keeps the members in the same order as the plan
-	// 	model.Members = state.Members
-	// }
-
 	if state == nil {
 		// If the plan is nil, return early.
 		return nil
@@ -43,6 +75,19 @@ func UpdateModel(role *discordgo.Role, members []*discordgo.Member, model, state
 
 	// Map the guild data to the state.
 	model.GuildID = state.GuildID
+	model.Mode = state.Mode
+
+	// The member IDs are only tracked when they are configured, instead of the usernames
+	if !state.MemberIDs.IsNull() {
+		memberIDsSet, diags := types.SetValueFrom(ctx, types.StringType, memberIDs(members))
+		if diags.HasError() {
+			return diags
+		}
+
+		model.MemberIDs = memberIDsSet
+	} else {
+		model.MemberIDs = types.SetNull(types.StringType)
+	}
 
 	return nil
 }
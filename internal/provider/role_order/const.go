@@ -0,0 +1,17 @@
+package role_order
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+const (
+	resourceMetadataName = "role_order"
+	resourceMetadataType = "resource"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &RoleOrderResource{}
+	_ resource.ResourceWithConfigure   = &RoleOrderResource{}
+	_ resource.ResourceWithImportState = &RoleOrderResource{}
+)
@@ -0,0 +1,26 @@
+package role_order
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// RoleOrderResource defines the resource implementation.
+type RoleOrderResource struct {
+	client *discordgo.Session
+}
+
+// RoleOrderResourceModel maps the resource schema data.
+type RoleOrderResourceModel struct {
+	// LastUpdated is the last time the resource was updated.
+	LastUpdated types.String `tfsdk:"last_updated"`
+
+	// The ID of the resource. This is the ID of the guild.
+	ID types.String `tfsdk:"id"`
+
+	// GuildID is the ID of the guild.
+	GuildID types.String `tfsdk:"guild_id"`
+
+	// The IDs of the roles, from the top to the bottom of the role hierarchy.
+	RoleIDs types.List `tfsdk:"role_ids"`
+}
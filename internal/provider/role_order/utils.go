@@ -0,0 +1,120 @@
+package role_order
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// setupParams validates the desired order and returns the roles to send in the reorder call.
+// The listed roles are placed in the slots they currently occupy, so roles that are not listed keep their place.
+// The roles must be sorted from the bottom to the top of the hierarchy, and highest is the highest role of the bot.
+func setupParams(roles []*discordgo.Role, guildID string, roleIDs []string, highest *discordgo.Role) ([]*discordgo.Role, error) {
+	// Only roles below the highest role of the bot can be moved
+	limit := len(roles)
+	if highest != nil {
+		limit = slices.Index(roles, highest)
+	}
+
+	slots := []int{}
+
+	for i, id := range roleIDs {
+		if slices.Contains(roleIDs[:i], id) {
+			return nil, fmt.Errorf("role %s is listed more than once", id)
+		}
+
+		if id == guildID {
+			return nil, fmt.Errorf("the @everyone role cannot be reordered")
+		}
+
+		idx := slices.IndexFunc(roles, func(r *discordgo.Role) bool {
+			return r.ID == id
+		})
+
+		if idx == -1 {
+			return nil, fmt.Errorf("role not found: id=%s", id)
+		}
+
+		if idx >= limit {
+			return nil, fmt.Errorf("role %s (%s) is not below the highest role of the bot, %s (%s)", roles[idx].Name, id, highest.Name, highest.ID)
+		}
+
+		slots = append(slots, idx)
+	}
+
+	slices.Sort(slots)
+
+	// Fill the slots from the bottom, the roles are listed from the top
+	order := slices.Clone(roles)
+	for i, slot := range slots {
+		id := roleIDs[len(roleIDs)-1-i]
+
+		order[slot] = roles[slices.IndexFunc(roles, func(r *discordgo.Role) bool {
+			return r.ID == id
+		})]
+	}
+
+	reorder := []*discordgo.Role{}
+
+	for i, r := range order {
+		// The @everyone role is always at the bottom
+		if i == 0 || i >= limit {
+			continue
+		}
+
+		if r.Position != i {
+			reorder = append(reorder, &discordgo.Role{
+				ID:       r.ID,
+				Position: i,
+			})
+		}
+	}
+
+	return reorder, nil
+}
+
+// UpdateModel updates the role order resource model with the current order of the roles.
+// Only roles that are managed by the model are reported, unless the model does not list any roles yet (import).
+// The roles must be sorted from the bottom to the top of the hierarchy.
+func UpdateModel(roles []*discordgo.Role, model, state *RoleOrderResourceModel, managed []string) diag.Diagnostics {
+	if model == nil {
+		model = &RoleOrderResourceModel{}
+	}
+
+	ids := []string{}
+
+	for i := len(roles) - 1; i >= 0; i-- {
+		r := roles[i]
+
+		// The @everyone role cannot be reordered
+		if managed == nil && state != nil && r.ID == state.GuildID.ValueString() {
+			continue
+		}
+
+		if managed == nil || slices.Contains(managed, r.ID) {
+			ids = append(ids, r.ID)
+		}
+	}
+
+	roleIDs, diags := common.ToListType[string, basetypes.StringType](ids)
+	if diags.HasError() {
+		return diags
+	}
+
+	model.RoleIDs = roleIDs
+
+	if state == nil {
+		// If the plan is nil, return early.
+		return nil
+	}
+
+	// Otherwise, update the model with additional data from the plan.
+	model.GuildID = state.GuildID
+	model.ID = state.GuildID
+
+	return nil
+}
@@ -0,0 +1,16 @@
+package roles
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+const (
+	datasourceMetadataName = "roles"
+	datasourceMetadataType = "data source"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &RolesDataSource{}
+	_ datasource.DataSourceWithConfigure = &RolesDataSource{}
+)
@@ -0,0 +1,186 @@
+package roles
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
+	tfrole "github.com/TheCodedCloud/terraform-provider-discord/internal/provider/role"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// NewRolesDataSource is a helper function to simplify the provider implementation.
+func NewRolesDataSource() datasource.DataSource {
+	return &RolesDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *RolesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + datasourceMetadataName
+}
+
+// Schema defines the schema for the data source.
+func (d *RolesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"guild_id": schema.StringAttribute{
+				Description: "The ID of the guild.",
+				Required:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only return roles whose name matches this regular expression.",
+				Optional:    true,
+			},
+			"managed": schema.BoolAttribute{
+				Description: "Only return roles with this managed setting, e.g. false to exclude bot and integration roles.",
+				Optional:    true,
+			},
+			"hoist": schema.BoolAttribute{
+				Description: "Only return roles with this hoist setting.",
+				Optional:    true,
+			},
+			"permissions": schema.ListAttribute{
+				Description: "Only return roles that have all of these permissions, e.g. 'MANAGE_MESSAGES'.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"roles": schema.ListNestedAttribute{
+				Description:  "The roles matching the filters, from the top to the bottom of the role hierarchy.",
+				Computed:     true,
+				NestedObject: tfrole.RoleSchema,
+			},
+			"ids_by_name": schema.MapAttribute{
+				Description: "The IDs of the matching roles, keyed by name. When several roles share a name, the highest one is used.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *RolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = tflog.SetField(ctx, "operation", "read")
+
+	var state RolesDataSourceModel
+
+	// Read the configuration data into the state struct.
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	required := map[string]attr.Value{
+		"guild_id": state.GuildID,
+	}
+
+	// Check for null/unknown values
+	common.CheckNonNull(required, resp.Diagnostics, datasourceMetadataName, datasourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check for required values
+	common.CheckRequired(ctx, required, resp.Diagnostics, datasourceMetadataName, datasourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissions := []string{}
+
+	if !state.Permissions.IsNull() {
+		permissions, diags = common.FromListType(ctx, state.Permissions)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	guild_id := state.GuildID.ValueString()
+
+	// Fetch data from the Discord client
+	// The roles are listed with their tags
+	all, tags, err := tfrole.FetchAllWithTags(ctx, d.client, guild_id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to get %s", datasourceMetadataName),
+			err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := filterRoles(all, &state, permissions)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Invalid %s Configuration", datasourceMetadataType),
+			err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Roles = make([]tfrole.RoleDataSourceModel, 0, len(result))
+	idsByName := map[string]string{}
+
+	for _, r := range result {
+		model, diags := tfrole.ToDataSourceModel(r, guild_id, tags[r.ID])
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state.Roles = append(state.Roles, *model)
+
+		if _, ok := idsByName[r.Name]; !ok {
+			idsByName[r.Name] = r.ID
+		}
+	}
+
+	state.IDsByName, diags = types.MapValueFrom(ctx, types.StringType, idsByName)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Read %d roles for %s %s", len(state.Roles), datasourceMetadataName, datasourceMetadataType))
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *RolesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Get the client from the provider data.
+	client, ok := req.ProviderData.(*discordgo.Session)
+	if !ok {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unexpected %s Configure Type", datasourceMetadataType),
+			fmt.Sprintf("Expected *discordgo.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,36 @@
+package roles
+
+import (
+	tfrole "github.com/TheCodedCloud/terraform-provider-discord/internal/provider/role"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// RolesDataSource defines the data source implementation.
+type RolesDataSource struct {
+	client *discordgo.Session
+}
+
+// RolesDataSourceModel maps the data source schema data.
+type RolesDataSourceModel struct {
+	// GuildID is the ID of the guild.
+	GuildID types.String `tfsdk:"guild_id"`
+
+	// Only return roles whose name matches this regular expression.
+	NameRegex types.String `tfsdk:"name_regex"`
+
+	// Only return roles with this managed setting.
+	Managed types.Bool `tfsdk:"managed"`
+
+	// Only return roles with this hoist setting.
+	Hoist types.Bool `tfsdk:"hoist"`
+
+	// Only return roles that have all of these permissions.
+	Permissions types.List `tfsdk:"permissions"`
+
+	// The roles matching the filters, from the top to the bottom of the role hierarchy.
+	Roles []tfrole.RoleDataSourceModel `tfsdk:"roles"`
+
+	// The IDs of the matching roles, keyed by name.
+	IDsByName types.Map `tfsdk:"ids_by_name"`
+}
@@ -0,0 +1,59 @@
+package roles
+
+import (
+	"fmt"
+	"regexp"
+
+	dcommon "github.com/JustARecord/go-discordutils/base/common"
+	"github.com/JustARecord/go-discordutils/base/role"
+	discord "github.com/JustARecord/go-discordutils/utils"
+	"github.com/bwmarrin/discordgo"
+)
+
+// filterRoles returns the roles matching the filters of the model, from the top to the bottom of the role hierarchy.
+func filterRoles(roles []*discordgo.Role, model *RolesDataSourceModel, permissions []string) ([]*discordgo.Role, error) {
+	var nameRegex *regexp.Regexp
+
+	if !model.NameRegex.IsNull() {
+		var err error
+
+		nameRegex, err = regexp.Compile(model.NameRegex.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("invalid name_regex: %w", err)
+		}
+	}
+
+	for _, p := range permissions {
+		if _, ok := dcommon.Permissions[p]; !ok {
+			return nil, fmt.Errorf("invalid permission: %s", p)
+		}
+	}
+
+	required := discord.CalcPermissions(permissions)
+
+	result := []*discordgo.Role{}
+
+	for _, r := range roles {
+		if nameRegex != nil && !nameRegex.MatchString(r.Name) {
+			continue
+		}
+
+		if !model.Managed.IsNull() && r.Managed != model.Managed.ValueBool() {
+			continue
+		}
+
+		if !model.Hoist.IsNull() && r.Hoist != model.Hoist.ValueBool() {
+			continue
+		}
+
+		if !discord.HasPermission(r.Permissions, required) {
+			continue
+		}
+
+		result = append(result, r)
+	}
+
+	role.SortByPosition(result)
+
+	return result, nil
+}
@@ -0,0 +1,24 @@
+package stage_instance
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+const (
+	resourceMetadataName = "stage_instance"
+	resourceMetadataType = "resource"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &StageInstanceResource{}
+	_ resource.ResourceWithConfigure   = &StageInstanceResource{}
+	_ resource.ResourceWithImportState = &StageInstanceResource{}
+)
+
+// PrivacyLevels maps the stage instance privacy levels to their names in the schema.
+var PrivacyLevels = map[discordgo.StageInstancePrivacyLevel]string{
+	discordgo.StageInstancePrivacyLevelPublic:    "public",
+	discordgo.StageInstancePrivacyLevelGuildOnly: "guild_only",
+}
@@ -0,0 +1,38 @@
+package stage_instance
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// StageInstanceResource defines the resource implementation.
+type StageInstanceResource struct {
+	client *discordgo.Session
+}
+
+// StageInstanceResourceModel maps the resource schema data.
+type StageInstanceResourceModel struct {
+	// LastUpdated is the last time the resource was updated.
+	LastUpdated types.String `tfsdk:"last_updated"`
+
+	// The ID of the stage instance.
+	ID types.String `tfsdk:"id"`
+
+	// The ID of the stage channel.
+	ChannelID types.String `tfsdk:"channel_id"`
+
+	// The ID of the guild of the stage channel.
+	GuildID types.String `tfsdk:"guild_id"`
+
+	// The topic of the stage instance.
+	Topic types.String `tfsdk:"topic"`
+
+	// The privacy level of the stage instance, either 'guild_only' or 'public'.
+	PrivacyLevel types.String `tfsdk:"privacy_level"`
+
+	// Whether to notify @everyone that the stage instance has started.
+	SendStartNotification types.Bool `tfsdk:"send_start_notification"`
+
+	// The ID of the scheduled event linked to the stage instance.
+	GuildScheduledEventID types.String `tfsdk:"guild_scheduled_event_id"`
+}
@@ -0,0 +1,100 @@
+package stage_instance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// stageParams holds the parameters to create a stage instance, including the scheduled event
+// that discordgo's StageInstanceParams does not send.
+type stageParams struct {
+	ChannelID             string                              `json:"channel_id,omitempty"`
+	Topic                 string                              `json:"topic,omitempty"`
+	PrivacyLevel          discordgo.StageInstancePrivacyLevel `json:"privacy_level,omitempty"`
+	SendStartNotification bool                                `json:"send_start_notification,omitempty"`
+	GuildScheduledEventID string                              `json:"guild_scheduled_event_id,omitempty"`
+}
+
+// ParsePrivacyLevel returns the privacy level with the provided name.
+func ParsePrivacyLevel(name string) (discordgo.StageInstancePrivacyLevel, bool) {
+	for level, n := range PrivacyLevels {
+		if n == name {
+			return level, true
+		}
+	}
+
+	return 0, false
+}
+
+func setupParams(model *StageInstanceResourceModel) (*stageParams, error) {
+	params := &stageParams{
+		ChannelID:             model.ChannelID.ValueString(),
+		Topic:                 model.Topic.ValueString(),
+		SendStartNotification: model.SendStartNotification.ValueBool(),
+		GuildScheduledEventID: model.GuildScheduledEventID.ValueString(),
+	}
+
+	// Set optional parameters
+	if !model.PrivacyLevel.IsNull() && !model.PrivacyLevel.IsUnknown() {
+		level, ok := ParsePrivacyLevel(model.PrivacyLevel.ValueString())
+		if !ok {
+			return nil, fmt.Errorf("invalid privacy level: %s", model.PrivacyLevel.ValueString())
+		}
+
+		params.PrivacyLevel = level
+	}
+
+	return params, nil
+}
+
+// createStageInstance creates a stage instance.
+// StageInstanceCreate is used unless a scheduled event is set, as it does not send the scheduled event.
+func createStageInstance(ctx context.Context, client *discordgo.Session, params *stageParams) (*discordgo.StageInstance, error) {
+	if params.GuildScheduledEventID == "" {
+		return client.StageInstanceCreate(&discordgo.StageInstanceParams{
+			ChannelID:             params.ChannelID,
+			Topic:                 params.Topic,
+			PrivacyLevel:          params.PrivacyLevel,
+			SendStartNotification: params.SendStartNotification,
+		}, discordgo.WithContext(ctx))
+	}
+
+	body, err := client.RequestWithBucketID("POST", discordgo.EndpointStageInstances, params, discordgo.EndpointStageInstances, discordgo.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	var result *discordgo.StageInstance
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// UpdateModel updates the stage instance resource model with the provided stage instance.
+func UpdateModel(result *discordgo.StageInstance, model, state *StageInstanceResourceModel) {
+	if model == nil {
+		model = &StageInstanceResourceModel{}
+	}
+
+	if state != nil {
+		// Map the configuration data to the state, as it is not returned by Discord.
+		model.SendStartNotification = state.SendStartNotification
+	}
+
+	model.ID = types.StringValue(result.ID)
+	model.ChannelID = types.StringValue(result.ChannelID)
+	model.GuildID = types.StringValue(result.GuildID)
+	model.Topic = types.StringValue(result.Topic)
+	model.PrivacyLevel = types.StringValue(PrivacyLevels[result.PrivacyLevel])
+
+	// Keep a null scheduled event when none is linked.
+	if result.GuildScheduledEventID != "" || !model.GuildScheduledEventID.IsNull() {
+		model.GuildScheduledEventID = types.StringValue(result.GuildScheduledEventID)
+	}
+}
@@ -0,0 +1,19 @@
+package threads
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+const (
+	datasourceMetadataName = "threads"
+	datasourceMetadataType = "data source"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &ThreadsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ThreadsDataSource{}
+)
+
+// archivedPageSize is the maximum number of archived threads Discord returns per request.
+const archivedPageSize = 100
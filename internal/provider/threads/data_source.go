@@ -0,0 +1,185 @@
+package threads
+
+import (
+	"context"
+	"fmt"
+
+	tfchannel "github.com/TheCodedCloud/terraform-provider-discord/internal/provider/channel"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// NewThreadsDataSource is a helper function to simplify the provider implementation.
+func NewThreadsDataSource() datasource.DataSource {
+	return &ThreadsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *ThreadsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + datasourceMetadataName
+}
+
+// Schema defines the schema for the data source.
+func (d *ThreadsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"channel_id": schema.StringAttribute{
+				Description: "The ID of the text, announcement or forum channel the threads were created in.",
+				Required:    true,
+			},
+			"include_archived": schema.BoolAttribute{
+				Description: "Whether to include the public archived threads. Defaults to true.",
+				Optional:    true,
+			},
+			"include_private_archived": schema.BoolAttribute{
+				Description: "Whether to include the private archived threads. This requires the Manage Threads permission. Defaults to false.",
+				Optional:    true,
+			},
+			"threads": schema.ListNestedAttribute{
+				Description:  "The threads of the channel. Active threads are listed first, followed by archived threads from the most recently archived.",
+				Computed:     true,
+				NestedObject: tfchannel.ChannelSchema,
+			},
+			"ids": schema.ListAttribute{
+				Description: "The IDs of the threads, in the same order as threads.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ThreadsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = tflog.SetField(ctx, "operation", "read")
+
+	var state ThreadsDataSourceModel
+
+	// Read the configuration data into the state struct.
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	required := map[string]attr.Value{
+		"channel_id": state.ChannelID,
+	}
+
+	// Check for null/unknown values
+	common.CheckNonNull(required, resp.Diagnostics, datasourceMetadataName, datasourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check for required values
+	common.CheckRequired(ctx, required, resp.Diagnostics, datasourceMetadataName, datasourceMetadataType)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channelID := state.ChannelID.ValueString()
+
+	// Fetch data from the Discord client
+	active, err := d.client.ThreadsActive(channelID, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to get active %s", datasourceMetadataName),
+			err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result := withMembers(active)
+
+	if state.IncludeArchived.IsNull() || state.IncludeArchived.ValueBool() {
+		archived, err := fetchArchived(ctx, d.client.ThreadsArchived, channelID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to get archived %s", datasourceMetadataName),
+				err.Error(),
+			)
+		}
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		result = append(result, archived...)
+	}
+
+	if state.IncludePrivateArchived.ValueBool() {
+		archived, err := fetchArchived(ctx, d.client.ThreadsPrivateArchived, channelID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to get private archived %s", datasourceMetadataName),
+				err.Error(),
+			)
+		}
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		result = append(result, archived...)
+	}
+
+	state.Threads = make([]tfchannel.ChannelDataSourceModel, 0, len(result))
+	ids := make([]string, 0, len(result))
+
+	for _, t := range result {
+		model, diags := tfchannel.ToDataSourceModel(t, nil)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state.Threads = append(state.Threads, *model)
+		ids = append(ids, t.ID)
+	}
+
+	state.IDs, diags = common.ToListType[string, basetypes.StringType](ids)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Read %d threads for %s %s", len(state.Threads), datasourceMetadataName, datasourceMetadataType))
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ThreadsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Get the client from the provider data.
+	client, ok := req.ProviderData.(*discordgo.Session)
+	if !ok {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unexpected %s Configure Type", datasourceMetadataType),
+			fmt.Sprintf("Expected *discordgo.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,30 @@
+package threads
+
+import (
+	tfchannel "github.com/TheCodedCloud/terraform-provider-discord/internal/provider/channel"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ThreadsDataSource defines the data source implementation.
+type ThreadsDataSource struct {
+	client *discordgo.Session
+}
+
+// ThreadsDataSourceModel maps the data source schema data.
+type ThreadsDataSourceModel struct {
+	// The ID of the channel the threads were created in.
+	ChannelID types.String `tfsdk:"channel_id"`
+
+	// Whether to include the public archived threads.
+	IncludeArchived types.Bool `tfsdk:"include_archived"`
+
+	// Whether to include the private archived threads.
+	IncludePrivateArchived types.Bool `tfsdk:"include_private_archived"`
+
+	// The threads of the channel, active threads first.
+	Threads []tfchannel.ChannelDataSourceModel `tfsdk:"threads"`
+
+	// The IDs of the threads.
+	IDs types.List `tfsdk:"ids"`
+}
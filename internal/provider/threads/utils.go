@@ -0,0 +1,55 @@
+package threads
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// archivedFetcher fetches a page of archived threads before the provided time.
+type archivedFetcher func(channelID string, before *time.Time, limit int, options ...discordgo.RequestOption) (*discordgo.ThreadsList, error)
+
+// withMembers sets the thread member of the current user on the threads of the list.
+func withMembers(list *discordgo.ThreadsList) []*discordgo.Channel {
+	for _, t := range list.Threads {
+		idx := slices.IndexFunc(list.Members, func(m *discordgo.ThreadMember) bool {
+			return m.ID == t.ID
+		})
+
+		if idx != -1 && t.Member == nil {
+			t.Member = list.Members[idx]
+		}
+	}
+
+	return list.Threads
+}
+
+// fetchArchived fetches all archived threads of the channel, following the pages of the provided fetcher.
+func fetchArchived(ctx context.Context, fetch archivedFetcher, channelID string) ([]*discordgo.Channel, error) {
+	result := []*discordgo.Channel{}
+
+	var before *time.Time
+
+	for {
+		list, err := fetch(channelID, before, archivedPageSize, discordgo.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, withMembers(list)...)
+
+		if !list.HasMore || len(list.Threads) == 0 {
+			return result, nil
+		}
+
+		// Archived threads are returned in descending order of their archive timestamp
+		last := list.Threads[len(list.Threads)-1]
+		if last.ThreadMetadata == nil {
+			return result, nil
+		}
+
+		before = &last.ThreadMetadata.ArchiveTimestamp
+	}
+}
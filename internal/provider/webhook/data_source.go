@@ -53,6 +53,10 @@ func (d *WebhookDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				Optional:    true,
 				Computed:    true,
 			},
+			"match_index": schema.Int32Attribute{
+				Description: common.MatchIndexDescription("webhook"),
+				Optional:    true,
+			},
 			"avatar": schema.StringAttribute{
 				Description: "The default user avatar hash of the webhook.",
 				Computed:    true,
@@ -66,6 +70,14 @@ func (d *WebhookDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				Description: "The Bot/OAuth2 application that created this webhook.",
 				Computed:    true,
 			},
+			"source_guild_id": schema.StringAttribute{
+				Description: "The ID of the guild of the channel that this webhook is following (returned for Channel Follower Webhooks).",
+				Computed:    true,
+			},
+			"source_channel_id": schema.StringAttribute{
+				Description: "The ID of the channel that this webhook is following (returned for Channel Follower Webhooks).",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -74,7 +86,7 @@ func (d *WebhookDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 func (d *WebhookDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	ctx = tflog.SetField(ctx, "operation", "read")
 
-	var state, provided WebhookDataSourceModel
+	var state, provided WebhookLookupModel
 
 	// Read the configuration data into the provided struct.
 	diags := req.Config.Get(ctx, &provided)
@@ -114,10 +126,13 @@ func (d *WebhookDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	if id != "" {
 		result, err = webhook.FetchByID(ctx, d.client, id)
 	} else if name != "" {
-		if channel_id != "" {
-			result, err = webhook.FetchChannelWebhookByName(ctx, d.client, channel_id, name)
-		} else if guild_id != "" {
-			result, err = webhook.FetchGuildWebhookByName(ctx, d.client, guild_id, name)
+		if channel_id != "" || guild_id != "" {
+			var webhooks []*discordgo.Webhook
+
+			webhooks, err = FetchAllByName(ctx, d.client, guild_id, channel_id, name)
+			if err == nil {
+				result, err = common.SelectMatch("webhook", name, webhooks, webhookID, provided.MatchIndex)
+			}
 		} else {
 			resp.Diagnostics.AddError(
 				fmt.Sprintf("Invalid %s Configuration", datasourceMetadataType),
@@ -143,15 +158,34 @@ func (d *WebhookDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	}
 
 	// Map the result data to the state.
-	state = WebhookDataSourceModel{
-		ID:            types.StringValue(result.ID),
-		Type:          types.StringValue(discord.Stringify(result.Type)),
-		GuildID:       types.StringValue(result.GuildID),
-		ChannelID:     types.StringValue(result.ChannelID),
-		Name:          types.StringValue(result.Name),
-		Avatar:        types.StringValue(result.Avatar),
-		Token:         types.StringValue(result.Token),
-		ApplicationID: types.StringValue(result.ApplicationID),
+	state.MatchIndex = provided.MatchIndex
+	state.WebhookDataSourceModel = WebhookDataSourceModel{
+		ID:              types.StringValue(result.ID),
+		Type:            types.StringValue(discord.Stringify(result.Type)),
+		GuildID:         types.StringValue(result.GuildID),
+		ChannelID:       types.StringValue(result.ChannelID),
+		Name:            types.StringValue(result.Name),
+		Avatar:          types.StringValue(result.Avatar),
+		Token:           types.StringValue(result.Token),
+		ApplicationID:   types.StringValue(result.ApplicationID),
+		SourceGuildID:   types.StringValue(""),
+		SourceChannelID: types.StringValue(""),
+	}
+
+	// Channel follower webhooks include the followed channel, which discordgo does not decode.
+	if result.Type == discordgo.WebhookTypeChannelFollower {
+		source, err := FetchSource(ctx, d.client, result.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to get %s source", datasourceMetadataName),
+				err.Error(),
+			)
+
+			return
+		}
+
+		state.SourceGuildID = types.StringValue(source.GuildID)
+		state.SourceChannelID = types.StringValue(source.ChannelID)
 	}
 
 	// Set state
@@ -0,0 +1,51 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/JustARecord/go-discordutils/base/webhook"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
+	"github.com/bwmarrin/discordgo"
+)
+
+// webhookID returns the ID of the webhook, used to order the webhooks matching a name.
+func webhookID(w *discordgo.Webhook) string {
+	return w.ID
+}
+
+// FetchAllByName fetches all the webhooks with the provided name in the channel, or in the guild when no channel is provided.
+func FetchAllByName(ctx context.Context, client *discordgo.Session, guildID, channelID, name string) ([]*discordgo.Webhook, error) {
+	var webhooks []*discordgo.Webhook
+	var err error
+
+	if channelID != "" {
+		webhooks, err = webhook.FetchChannelWebhooks(ctx, client, channelID)
+	} else {
+		webhooks, err = webhook.FetchGuildWebhooks(ctx, client, guildID)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*discordgo.Webhook{}
+
+	for _, w := range webhooks {
+		if w.Name == name {
+			result = append(result, w)
+		}
+	}
+
+	return result, nil
+}
+
+// FetchByName fetches the webhook with the provided name in the channel, or in the guild when no channel is provided.
+// An error listing the candidate IDs is returned when several webhooks share the name.
+func FetchByName(ctx context.Context, client *discordgo.Session, guildID, channelID, name string) (*discordgo.Webhook, error) {
+	webhooks, err := FetchAllByName(ctx, client, guildID, channelID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return common.SelectUnique("webhook", name, webhooks, webhookID)
+}
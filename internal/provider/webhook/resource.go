@@ -5,9 +5,9 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/JustARecord/go-discordutils/base/channel"
 	"github.com/JustARecord/go-discordutils/base/webhook"
 	discord "github.com/JustARecord/go-discordutils/utils"
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/channel"
 	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
 	"github.com/bwmarrin/discordgo"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -100,6 +100,20 @@ func (r *WebhookResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"source_guild_id": schema.StringAttribute{
+				Description: "The ID of the guild of the channel that this webhook is following (returned for Channel Follower Webhooks).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_channel_id": schema.StringAttribute{
+				Description: "The ID of the channel that this webhook is following (returned for Channel Follower Webhooks).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
@@ -368,16 +382,13 @@ func (r *WebhookResource) ImportState(ctx context.Context, req resource.ImportSt
 			resource = idParts[3]
 
 			// Input is a channel name, fetch the channel ID
-			channel, err := channel.FetchByName(ctx, r.client, guildID, channelID)
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Failed to import state",
-					err.Error(),
-				)
+			result, diags := channel.FetchByName(ctx, r.client, guildID, channelID)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
 				return
 			}
 
-			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel_id"), types.StringValue(channel.ID))...)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel_id"), types.StringValue(result.ID))...)
 		}
 
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), types.StringValue(resource))...)
@@ -431,10 +442,8 @@ func (r *WebhookResource) Read(ctx context.Context, req resource.ReadRequest, re
 	if id != "" {
 		result, err = webhook.FetchByID(ctx, r.client, id)
 	} else if name != "" {
-		if channel_id != "" {
-			result, err = webhook.FetchChannelWebhookByName(ctx, r.client, channel_id, name)
-		} else if guild_id != "" {
-			result, err = webhook.FetchGuildWebhookByName(ctx, r.client, guild_id, name)
+		if channel_id != "" || guild_id != "" {
+			result, err = FetchByName(ctx, r.client, guild_id, channel_id, name)
 		} else {
 			resp.Diagnostics.AddError(
 				fmt.Sprintf("Invalid %s Configuration", datasourceMetadataType),
@@ -465,6 +474,22 @@ func (r *WebhookResource) Read(ctx context.Context, req resource.ReadRequest, re
 		resp.Diagnostics.Append(diags...)
 	}
 
+	// Channel follower webhooks include the followed channel, which discordgo does not decode.
+	if result.Type == discordgo.WebhookTypeChannelFollower {
+		source, err := FetchSource(ctx, r.client, result.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to get %s source", resourceMetadataName),
+				err.Error(),
+			)
+
+			return
+		}
+
+		provided.SourceGuildID = types.StringValue(source.GuildID)
+		provided.SourceChannelID = types.StringValue(source.ChannelID)
+	}
+
 	// When state contains image data (base64/data URL), keep it so plan continues to match state.
 	// Otherwise refresh from API (hash or "").
 	if !isAvatarImageData(provided.Avatar.ValueString()) {
@@ -10,6 +10,14 @@ type WebhookDataSource struct {
 	client *discordgo.Session
 }
 
+// WebhookLookupModel maps the data source schema data, including the attributes used only to look up the webhook.
+type WebhookLookupModel struct {
+	// The index of the webhook to select when several webhooks share the name.
+	MatchIndex types.Int32 `tfsdk:"match_index"`
+
+	WebhookDataSourceModel
+}
+
 // WebhookDataSourceModel maps the data source schema data.
 type WebhookDataSourceModel struct {
 	// The ID of the webhook.
@@ -36,11 +44,11 @@ type WebhookDataSourceModel struct {
 	// The Bot/OAuth2 application that created this webhook.
 	ApplicationID types.String `tfsdk:"application_id"`
 
-	// The partial guild of the channel that this webhook is following (returned for Channel Follower Webhooks).
-	// SourceGuild types.String `tfsdk:"source_guild"`
+	// The ID of the guild of the channel that this webhook is following (returned for Channel Follower Webhooks).
+	SourceGuildID types.String `tfsdk:"source_guild_id"`
 
-	// The partial channel that this webhook is following (returned for Channel Follower Webhooks).
-	// SourceChannel types.String `tfsdk:"source_channel"`
+	// The ID of the channel that this webhook is following (returned for Channel Follower Webhooks).
+	SourceChannelID types.String `tfsdk:"source_channel_id"`
 }
 
 // WebhookResource defines the resource implementation.
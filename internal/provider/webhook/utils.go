@@ -2,6 +2,7 @@ package webhook
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -28,6 +29,8 @@ func UpdateModel(ctx context.Context, webhook *discordgo.Webhook, model, state *
 	model.ApplicationID = types.StringValue(webhook.ApplicationID)
 	model.GuildID = types.StringValue(webhook.GuildID)
 	model.ChannelID = types.StringValue(webhook.ChannelID)
+	model.SourceGuildID = types.StringValue("")
+	model.SourceChannelID = types.StringValue("")
 
 	if state == nil {
 		return nil
@@ -39,6 +42,46 @@ func UpdateModel(ctx context.Context, webhook *discordgo.Webhook, model, state *
 	return nil
 }
 
+// WebhookSource is the channel that a channel follower webhook is following.
+type WebhookSource struct {
+	GuildID   string
+	ChannelID string
+}
+
+// FetchSource fetches the source guild and channel of a channel follower webhook.
+// discordgo does not decode the source_guild and source_channel fields, so the webhook is requested directly.
+func FetchSource(ctx context.Context, client *discordgo.Session, id string) (*WebhookSource, error) {
+	body, err := client.RequestWithBucketID("GET", discordgo.EndpointWebhook(id), nil, discordgo.EndpointWebhooks, discordgo.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		SourceGuild *struct {
+			ID string `json:"id"`
+		} `json:"source_guild"`
+		SourceChannel *struct {
+			ID string `json:"id"`
+		} `json:"source_channel"`
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, err
+	}
+
+	source := &WebhookSource{}
+
+	if result.SourceGuild != nil {
+		source.GuildID = result.SourceGuild.ID
+	}
+
+	if result.SourceChannel != nil {
+		source.ChannelID = result.SourceChannel.ID
+	}
+
+	return source, nil
+}
+
 // avatarStateAfterApply returns the value to store in state for avatar after a successful Create or Update.
 // We store exactly what we sent so the plan (config) matches state and Terraform does not report
 // "planned value does not match config value" or "inconsistent result". When the user sends image
@@ -0,0 +1,17 @@
+package webhook_message
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+const (
+	resourceMetadataName = "webhook_message"
+	resourceMetadataType = "resource"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &WebhookMessageResource{}
+	_ resource.ResourceWithConfigure   = &WebhookMessageResource{}
+	_ resource.ResourceWithImportState = &WebhookMessageResource{}
+)
@@ -0,0 +1,48 @@
+package webhook_message
+
+import (
+	"github.com/TheCodedCloud/terraform-provider-discord/internal/provider/common"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// WebhookMessageResource defines the resource implementation.
+type WebhookMessageResource struct {
+	client *discordgo.Session
+}
+
+// WebhookMessageResourceModel maps the resource schema data.
+type WebhookMessageResourceModel struct {
+	// LastUpdated is the last time the resource was updated.
+	LastUpdated types.String `tfsdk:"last_updated"`
+
+	// The ID of the message.
+	ID types.String `tfsdk:"id"`
+
+	// The ID of the webhook that sends the message.
+	WebhookID types.String `tfsdk:"webhook_id"`
+
+	// The token of the webhook. Fetched with the bot token when not provided.
+	WebhookToken types.String `tfsdk:"webhook_token"`
+
+	// The ID of the thread to send the message in, if any.
+	ThreadID types.String `tfsdk:"thread_id"`
+
+	// The ID of the channel the message is sent in.
+	ChannelID types.String `tfsdk:"channel_id"`
+
+	// The username to send the message with, instead of the webhook name.
+	Username types.String `tfsdk:"username"`
+
+	// The URL of the avatar to send the message with, instead of the webhook avatar.
+	AvatarURL types.String `tfsdk:"avatar_url"`
+
+	// The content of the message.
+	Content types.String `tfsdk:"content"`
+
+	// The embeds of the message.
+	Embeds []common.Embed `tfsdk:"embeds"`
+
+	// The mentions that are allowed to ping in the message.
+	AllowedMentions *common.AllowedMentions `tfsdk:"allowed_mentions"`
+}
@@ -0,0 +1,93 @@
+package webhook_message
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/JustARecord/go-discordutils/base/webhook"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// threadChannel returns the thread to target, or nil when the message is not sent in a thread.
+func threadChannel(model *WebhookMessageResourceModel) *discordgo.Channel {
+	if model.ThreadID.IsNull() || model.ThreadID.ValueString() == "" {
+		return nil
+	}
+
+	return &discordgo.Channel{ID: model.ThreadID.ValueString()}
+}
+
+// threadURI returns the webhook message endpoint, targeting the thread if provided.
+func threadURI(wh *discordgo.Webhook, messageID string, thread *discordgo.Channel) string {
+	return discordgo.EndpointWebhookMessage(wh.ID, wh.Token, messageID) + "?thread_id=" + thread.ID
+}
+
+// fetchMessage fetches a webhook message. go-discordutils does not support fetching thread messages,
+// so messages in a thread are requested directly.
+func fetchMessage(ctx context.Context, client *discordgo.Session, wh *discordgo.Webhook, messageID string, thread *discordgo.Channel) (*discordgo.Message, error) {
+	if thread == nil {
+		return webhook.FetchMessage(ctx, client, wh, messageID)
+	}
+
+	body, err := client.RequestWithBucketID("GET", threadURI(wh, messageID, thread), nil, discordgo.EndpointWebhookToken("", ""), discordgo.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	var result *discordgo.Message
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// deleteMessage deletes a webhook message. go-discordutils does not support deleting thread messages,
+// so messages in a thread are deleted directly.
+func deleteMessage(ctx context.Context, client *discordgo.Session, wh *discordgo.Webhook, messageID string, thread *discordgo.Channel) error {
+	if thread == nil {
+		return webhook.DeleteMessageID(ctx, client, wh.ID, wh.Token, messageID)
+	}
+
+	_, err := client.RequestWithBucketID("DELETE", threadURI(wh, messageID, thread), nil, discordgo.EndpointWebhookToken("", ""), discordgo.WithContext(ctx))
+
+	return err
+}
+
+// UpdateModel updates the webhook message resource model with the provided message.
+func UpdateModel(message *discordgo.Message, model, state *WebhookMessageResourceModel) {
+	if model == nil {
+		model = &WebhookMessageResourceModel{}
+	}
+
+	model.ID = types.StringValue(message.ID)
+	model.WebhookID = types.StringValue(message.WebhookID)
+	model.ChannelID = types.StringValue(message.ChannelID)
+
+	// Keep a null content when the message has no content.
+	if message.Content != "" || !model.Content.IsNull() {
+		model.Content = types.StringValue(message.Content)
+	}
+
+	if state == nil {
+		// If the plan is nil, return early.
+		return
+	}
+
+	// Otherwise, update the model with additional data from the plan.
+
+	// The token, thread and overrides are only used when sending the message.
+	model.WebhookToken = state.WebhookToken
+	model.ThreadID = state.ThreadID
+	model.Username = state.Username
+	model.AvatarURL = state.AvatarURL
+
+	// Embeds and mentions are owned by the configuration, as Discord adds computed data to them or does not return them.
+	model.Embeds = state.Embeds
+	model.AllowedMentions = state.AllowedMentions
+
+	if model.Content.IsNull() && !state.Content.IsNull() && state.Content.ValueString() == "" {
+		model.Content = state.Content
+	}
+}